@@ -0,0 +1,91 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AllowDuplicateUUIDAnnotationKey is the annotation that allows a ContentLibrary or ClusterContentLibrary
+	// to refer to a vCenter library that another library resource already refers to, e.g. to intentionally
+	// project the same library into multiple namespaces. The only recognized value is "true".
+	AllowDuplicateUUIDAnnotationKey = GroupName + "/allow-duplicate-uuid"
+
+	// PassthroughAnnotationPrefix is the prefix of the library item annotations that downstream controllers
+	// copy, unchanged, to the objects they derive from the item, such as VirtualMachineImage resources.
+	PassthroughAnnotationPrefix = GroupName + "/passthrough-"
+
+	// ManagedByAnnotationKey is the annotation that holds the name of the controller managing a resource,
+	// e.g. "imageregistry-operator". Controllers leave alone the resources managed by another controller.
+	ManagedByAnnotationKey = GroupName + "/managed-by"
+
+	// AdoptedFromAnnotationKey is the annotation that records that a resource was not created by the
+	// controller managing it, but adopted from a previous operator version or from a manually created
+	// resource. Its value describes the origin, e.g. AdoptedFromManual or the previous operator version.
+	// The update that adopts a resource must not change its spec, and once set the annotation is immutable.
+	AdoptedFromAnnotationKey = GroupName + "/adopted-from"
+
+	// AdoptedFromManual is the value of the AdoptedFromAnnotationKey annotation of resources that were
+	// created manually.
+	AdoptedFromManual = "manual"
+
+	// ConfirmDeleteAnnotationKey is the annotation that confirms that deleting a ContentLibrary with the
+	// "Delete" deletion policy deletes the library and its content in vCenter. Its value must be the name of
+	// the ContentLibrary, so that the confirmation cannot be copied to another library by accident.
+	ConfirmDeleteAnnotationKey = GroupName + "/confirm-delete"
+
+	// MaxInFlightTransfersAnnotationKey is the annotation of a Namespace that lowers the
+	// maxInFlightTransfersPerNamespace of the ContentLibraryConfiguration for that namespace. Its value is a
+	// positive integer; values above the maximum of the ContentLibraryConfiguration are ignored.
+	MaxInFlightTransfersAnnotationKey = GroupName + "/max-inflight-transfers"
+)
+
+// ManagedBy returns the name of the controller managing obj, or an empty string if it is not set.
+func ManagedBy(obj metav1.Object) string {
+	return obj.GetAnnotations()[ManagedByAnnotationKey]
+}
+
+// SetManagedBy records manager as the controller managing obj.
+func SetManagedBy(obj metav1.Object, manager string) {
+	setAnnotation(obj, ManagedByAnnotationKey, manager)
+}
+
+// MarkAdopted records that obj was adopted by manager from the given origin, e.g. AdoptedFromManual.
+func MarkAdopted(obj metav1.Object, manager, from string) {
+	setAnnotation(obj, ManagedByAnnotationKey, manager)
+	setAnnotation(obj, AdoptedFromAnnotationKey, from)
+}
+
+// AdoptedFrom returns the origin obj was adopted from, and whether it was adopted.
+func AdoptedFrom(obj metav1.Object) (string, bool) {
+	from, ok := obj.GetAnnotations()[AdoptedFromAnnotationKey]
+	return from, ok
+}
+
+func setAnnotation(obj metav1.Object, key, value string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	obj.SetAnnotations(annotations)
+}
+
+// PassthroughAnnotations returns the annotations of obj that must be copied to the objects derived from it,
+// i.e. those with the PassthroughAnnotationPrefix. It returns nil if there are none.
+func PassthroughAnnotations(obj metav1.Object) map[string]string {
+	var passthrough map[string]string
+	for key, value := range obj.GetAnnotations() {
+		if !strings.HasPrefix(key, PassthroughAnnotationPrefix) {
+			continue
+		}
+		if passthrough == nil {
+			passthrough = map[string]string{}
+		}
+		passthrough[key] = value
+	}
+	return passthrough
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The approval workflow lets organizations require a human sign-off before the content of a library item is
+// used. The sign-offs are recorded in the ApprovalsAnnotationKey annotation of the ContentLibraryItem or
+// ClusterContentLibraryItem, each for the digest of the content it approves, so that an approval does not
+// carry over to new content. The content library operator reports the ApprovalRequired and Approved
+// conditions with conditions.SetApproval, and Approved gates the Ready condition of items that require
+// approval.
+const (
+	// ApprovalsAnnotationKey is the annotation that records the approvals of the content of a library item.
+	// Its value is a JSON list of Approval objects, maintained with AddApproval. Webhooks restrict who may
+	// change it with validation.ValidateApprovals.
+	ApprovalsAnnotationKey = GroupName + "/approvals"
+
+	// ConditionApprovalRequired indicates whether the content of a library item requires an approval before
+	// it can be used, as decided by the policy of the organization.
+	ConditionApprovalRequired = ConditionType("ApprovalRequired")
+
+	// ConditionApproved indicates whether the current content of a library item that requires an approval
+	// was approved. It is not reported for items that do not require an approval.
+	ConditionApproved = ConditionType("Approved")
+
+	// ApprovalNotRequiredReason documents that the content of a library item does not require an approval.
+	ApprovalNotRequiredReason = "ApprovalNotRequired"
+
+	// ApprovalPendingReason documents that the content of a library item was not approved yet.
+	ApprovalPendingReason = "ApprovalPending"
+
+	// ApprovalStaleReason documents that the approvals of a library item were given for another content,
+	// e.g. before the content of the item was updated.
+	ApprovalStaleReason = "ApprovalStale"
+)
+
+// Approval records the sign-off of the content of a library item.
+type Approval struct {
+	// Approver is the user name of the user who approved the content, as authenticated by the API server.
+	// Webhooks reject approvals recorded by one user on behalf of another.
+	Approver string `json:"approver"`
+
+	// Time is the time of the approval.
+	Time metav1.Time `json:"time"`
+
+	// Digest is the digest of the approved content, e.g. "sha256:...".
+	Digest string `json:"digest"`
+}
+
+// Approvals returns the approvals recorded on obj. It returns an error if the ApprovalsAnnotationKey
+// annotation cannot be parsed.
+func Approvals(obj metav1.Object) ([]Approval, error) {
+	value, ok := obj.GetAnnotations()[ApprovalsAnnotationKey]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var approvals []Approval
+	if err := json.Unmarshal([]byte(value), &approvals); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", ApprovalsAnnotationKey, err)
+	}
+	return approvals, nil
+}
+
+// AddApproval records that approver approved the content with the given digest at the given time. It
+// replaces any previous approval of the same approver.
+func AddApproval(obj metav1.Object, approver, digest string, at time.Time) error {
+	approvals, err := Approvals(obj)
+	if err != nil {
+		return err
+	}
+
+	approval := Approval{Approver: approver, Time: metav1.NewTime(at.UTC()), Digest: digest}
+	replaced := false
+	for i := range approvals {
+		if approvals[i].Approver == approver {
+			approvals[i], replaced = approval, true
+		}
+	}
+	if !replaced {
+		approvals = append(approvals, approval)
+	}
+
+	data, err := json.Marshal(approvals)
+	if err != nil {
+		return err
+	}
+	setAnnotation(obj, ApprovalsAnnotationKey, string(data))
+	return nil
+}
+
+// ApprovalsOf returns the approvals recorded on obj for the content with the given digest. Approvals that
+// cannot be parsed are ignored.
+func ApprovalsOf(obj metav1.Object, digest string) []Approval {
+	approvals, _ := Approvals(obj)
+	var result []Approval
+	for _, approval := range approvals {
+		if approval.Digest == digest {
+			result = append(result, approval)
+		}
+	}
+	return result
+}
@@ -3,14 +3,59 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ClusterContentLibrarySpec defines the desired state of a ClusterContentLibrary.
+// +kubebuilder:validation:XValidation:rule="has(self.vCenterRef) == has(oldSelf.vCenterRef)",message="vCenterRef is immutable"
 type ClusterContentLibrarySpec struct {
 	// UUID is the identifier which uniquely identifies the library in vCenter. This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="uuid is immutable"
 	// +required
-	UUID string `json:"uuid"`
+	UUID LibraryID `json:"uuid"`
+
+	// VCenterRef refers to the vCenter the library belongs to. If unset, the default vCenter of the
+	// supervisor is assumed. This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vCenterRef is immutable"
+	// +optional
+	VCenterRef *VCenterReference `json:"vCenterRef,omitempty"`
+
+	// Writable flag indicates if new library items can be created in this library, and the existing library
+	// items modified, from Kubernetes. Only a library of the "Local" type can be writable.
+	// +optional
+	Writable bool `json:"writable,omitempty"`
+
+	// AllowedNamespaces selects the namespaces from which library items can be imported into this library,
+	// e.g. with a ContentLibraryItemImportRequest. If unset, library items cannot be imported from any
+	// namespace, and an empty selector allows every namespace.
+	// This field applies only if the library is writable.
+	// +optional
+	AllowedNamespaces *metav1.LabelSelector `json:"allowedNamespaces,omitempty"`
+
+	// Proxy specifies the proxy used to reach the subscription URL of the library. If unset, the vCenter-wide
+	// proxy settings apply. This field applies only if the library is of the "Subscribed" type.
+	// The namespace of Proxy.CredentialsSecretRef must be set for cluster scoped libraries.
+	// +optional
+	Proxy *ProxyConfiguration `json:"proxy,omitempty"`
+
+	// SyncFailurePolicy describes how the failed synchronizations of the library are retried. If unset, the
+	// defaults of the content library operator apply.
+	// This field applies only if the library is of the "Subscribed" type.
+	// +optional
+	SyncFailurePolicy *SyncFailurePolicy `json:"syncFailurePolicy,omitempty"`
+
+	// TransferRateLimit is the maximum rate, in bytes per second, at which the content of the library items
+	// is transferred when the library is synchronized, e.g. "100Mi". If unset, transfers are not limited.
+	// +optional
+	TransferRateLimit *resource.Quantity `json:"transferRateLimit,omitempty"`
+
+	// ResyncIntervalSeconds is the interval, in seconds, at which the library is reconciled with vCenter. It
+	// overrides the defaultSyncInterval of the ContentLibraryConfiguration for this library, e.g. to re-list
+	// large static libraries less often than rapidly changing ones. If unset, the default interval applies.
+	// +kubebuilder:validation:Minimum=60
+	// +optional
+	ResyncIntervalSeconds *int32 `json:"resyncIntervalSeconds,omitempty"`
 }
 
 // ClusterContentLibraryStatus defines the observed state of ClusterContentLibrary.
@@ -19,6 +64,12 @@ type ClusterContentLibraryStatus struct {
 	// +required
 	Name string `json:"name"`
 
+	// VCenterInstanceUUID is the instance UUID of the vCenter the library belongs to.
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self.matches('^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$')",message="must be a UUID of the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+	// +optional
+	VCenterInstanceUUID string `json:"vCenterInstanceUUID,omitempty"`
+
 	// Description is a human-readable description for this library.
 	// +optional
 	Description string `json:"description,omitempty"`
@@ -61,21 +112,78 @@ type ClusterContentLibraryStatus struct {
 	// +optional
 	LastSyncTime string `json:"lastSyncTime,omitempty"`
 
+	// ResyncIntervalSeconds is the effective interval, in seconds, at which the library is reconciled with
+	// vCenter, i.e. spec.resyncIntervalSeconds if set, or the default interval of the operator otherwise.
+	// +optional
+	ResyncIntervalSeconds int32 `json:"resyncIntervalSeconds,omitempty"`
+
+	// Permissions summarizes the vCenter principals that can modify the library, so that they can be audited
+	// from Kubernetes. It is refreshed periodically.
+	// +optional
+	Permissions *PermissionsInfo `json:"permissions,omitempty"`
+
+	// ProviderCapabilities describes the content library features supported by the vCenter the library
+	// belongs to. It is refreshed when the version of the vCenter changes.
+	// +optional
+	ProviderCapabilities *ProviderCapabilities `json:"providerCapabilities,omitempty"`
+
+	// ConsecutiveFailures is the number of synchronizations of the library that failed in a row. It is reset
+	// when a synchronization succeeds.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// LastObservedTime indicates the time when the controller last reconciled the library. It is updated on
+	// every reconciliation, even when nothing changed, so that a wedged controller can be detected.
+	// +optional
+	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
+
+	// LastDriftCheckTime indicates the time when the library was last compared with vCenter to detect
+	// out-of-band changes.
+	// +optional
+	LastDriftCheckTime *metav1.Time `json:"lastDriftCheckTime,omitempty"`
+
+	// LastTaskInfo describes the last vCenter task that failed for this library.
+	// +optional
+	LastTaskInfo *TaskInfo `json:"lastTaskInfo,omitempty"`
+
+	// LastError describes the last error encountered while reconciling the library, and whether it is retried.
+	// This field is cleared once the library is reconciled successfully.
+	// +optional
+	LastError *ErrorInfo `json:"lastError,omitempty"`
+
+	// Summary is a one-line human readable summary of the state of the ClusterContentLibrary, e.g. "Ready" or
+	// "Error: publisher certificate expired", derived from its conditions by conditions.ReadyMessage.
+	// +optional
+	Summary string `json:"summary,omitempty"`
+
 	// Conditions describes the current condition information of the ClusterContentLibrary.
 	// +optional
-	Conditions Conditions `json:"conditions,omitempty"`
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (clusterContentLibrary *ClusterContentLibrary) GetConditions() Conditions {
+	return clusterContentLibrary.Status.Conditions
+}
+
+func (clusterContentLibrary *ClusterContentLibrary) SetConditions(conditions Conditions) {
+	clusterContentLibrary.Status.Conditions = conditions
 }
 
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,shortName=ccl
+// +kubebuilder:resource:scope=Cluster,shortName=ccl;clusterlib,categories=imageregistry;vmware
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".status.type"
-// +kubebuilder:printcolumn:name="StorageType",type="string",JSONPath=".status.storageBacking.storageType"
+// +kubebuilder:printcolumn:name="Writable",type="boolean",JSONPath=".spec.writable"
+// +kubebuilder:printcolumn:name="StorageType",type="string",JSONPath=".status.storageBacking.type"
+// +kubebuilder:printcolumn:name="Summary",type="string",priority=1,JSONPath=".status.summary"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="LastSyncTime",type="string",JSONPath=".status.lastSyncTime"
 
 // ClusterContentLibrary is the schema for the cluster scoped content library API.
-// Currently, ClusterContentLibrary is immutable to end users.
+// The library items of a ClusterContentLibrary can only be created or modified from Kubernetes if the library
+// is writable.
 type ClusterContentLibrary struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -9,13 +9,40 @@ import (
 // ClusterContentLibraryItemSpec defines the desired state of a ClusterContentLibraryItem.
 type ClusterContentLibraryItemSpec struct {
 	// UUID is the identifier which uniquely identifies the library item in vCenter. This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="uuid is immutable"
 	// +required
-	UUID string `json:"uuid"`
+	UUID ItemID `json:"uuid"`
+
+	// AttestationRef refers to the signatures or attestations of the content of the library item. When set,
+	// the content is verified against them and the result is reported by the SignatureVerified condition.
+	// +optional
+	AttestationRef *AttestationReference `json:"attestationRef,omitempty"`
+
+	// Name is the desired name of the library item in vCenter. When set, the library item is renamed in vCenter
+	// to match, and Status.Name reports the actual name once the rename is done.
+	// This field can only be set for items of a writable ClusterContentLibrary.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Description is the desired human-readable description of the library item in vCenter. When set, the
+	// description is updated in vCenter to match, and Status.Description reports the actual description.
+	// This field can only be set for items of a writable ClusterContentLibrary.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// CustomMetadata is the desired custom metadata of the library item in vCenter. When set, the metadata of
+	// the item in vCenter is updated to match.
+	// The keys and values must not exceed CustomMetadataMaxBytes in total.
+	// This field can only be set for items of a writable ClusterContentLibrary.
+	// +kubebuilder:validation:MaxProperties=64
+	// +mapType=granular
+	// +optional
+	CustomMetadata map[string]string `json:"customMetadata,omitempty"`
 }
 
 // ClusterContentLibraryItemStatus defines the observed state of ClusterContentLibraryItem.
 type ClusterContentLibraryItemStatus struct {
-	// ItemName specifies the name of the content library item in vCenter.
+	// Name specifies the name of the content library item in vCenter.
 	// +required
 	Name string `json:"name"`
 
@@ -27,6 +54,52 @@ type ClusterContentLibraryItemStatus struct {
 	// +required
 	ClusterContentLibraryRef string `json:"clusterContentLibraryRef"`
 
+	// SourceLibraryType indicates the type of the ClusterContentLibrary this item belongs to.
+	// Possible types are "Local" and "Subscribed".
+	// +optional
+	SourceLibraryType ContentLibraryType `json:"sourceLibraryType,omitempty"`
+
+	// PublisherInfo identifies the published library the item was synchronized from.
+	// This field is populated only if SourceLibraryType is "Subscribed".
+	// +optional
+	PublisherInfo *PublisherInfo `json:"publisherInfo,omitempty"`
+
+	// CustomMetadata is the custom metadata of the library item in vCenter.
+	// +mapType=granular
+	// +optional
+	CustomMetadata map[string]string `json:"customMetadata,omitempty"`
+
+	// SourceItemRef refers to the library item this library item was copied from, if it was copied from
+	// another library.
+	// +optional
+	SourceItemRef *SourceItemReference `json:"sourceItemRef,omitempty"`
+
+	// CloneGeneration is the number of copies between the original library item and this library item, e.g.
+	// 2 for the namespace copy of a regional copy of a golden image. It is 0 for library items that were not
+	// copied.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	CloneGeneration int32 `json:"cloneGeneration,omitempty"`
+
+	// ImageRefs refers to the ClusterVirtualMachineImage resources generated from the library item, so that the image
+	// of a library item can be found without searching by UUID.
+	// +listType=map
+	// +listMapKey=kind
+	// +listMapKey=name
+	// +optional
+	ImageRefs []NameAndKindRef `json:"imageRefs,omitempty"`
+
+	// OCIRef is the OCI-style reference of the current content of the library item, of the form
+	// "registry/<library-uuid>/<item-uuid>:v<content-version>@<digest>", so that OCI signing, SBOM and policy
+	// tools can refer to it. This field is populated only if the operator is configured with a registry.
+	// +optional
+	OCIRef string `json:"ociRef,omitempty"`
+
+	// SBOMRef refers to the software bill of materials of the current content of the library item, attached
+	// by the pipeline that built the image.
+	// +optional
+	SBOMRef *SBOMReference `json:"sbomRef,omitempty"`
+
 	// MetadataVersion indicates the version of the library item metadata.
 	// This value is incremented when the library item properties such as name or description are changed in vCenter.
 	// +required
@@ -38,22 +111,95 @@ type ClusterContentLibraryItemStatus struct {
 	ContentVersion string `json:"contentVersion"`
 
 	// Type string indicates the type of the library item in vCenter.
-	// Possible types are "Ovf" and "Iso".
+	// Possible types are "Ovf", "Iso", "File" and "Unknown". Items of a type that is not supported by this API
+	// have the "Unknown" type, and their TypeSupported condition is false.
 	// +required
 	Type ContentLibraryItemType `json:"type"`
 
+	// RawType is the type of the library item as reported by vCenter, e.g. "ovf" or "iso". It is recorded for
+	// every item, and in particular explains the items of the "Unknown" type.
+	// +optional
+	RawType string `json:"rawType,omitempty"`
+
 	// Size indicates the library item size in bytes
 	// +optional
 	Size int32 `json:"size,omitempty"`
 
+	// Files describes the files of the library item.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Files []FileInfo `json:"files,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// DeploymentDefaults describes the default values for deploying a VM from the OVF.
+	// This field is populated only if the library item is of the "Ovf" type.
+	// +optional
+	DeploymentDefaults *DeploymentDefaults `json:"deploymentDefaults,omitempty"`
+
+	// HardwareVersion is the virtual hardware version of the VMs described by the OVF, e.g. 19 for "vmx-19".
+	// This field is populated only if the library item is of the "Ovf" type.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HardwareVersion int32 `json:"hardwareVersion,omitempty"`
+
+	// MinSupportedHWVersion is the highest virtual hardware version supported by all the target clusters,
+	// i.e. the lowest of the highest hardware versions the clusters support. The HardwareVersionSupported
+	// condition is false if HardwareVersion exceeds it.
+	// This field is populated only if the library item is of the "Ovf" type.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinSupportedHWVersion int32 `json:"minSupportedHWVersion,omitempty"`
+
+	// EULAs are the license agreements of the EulaSection of the OVF descriptor, which must be presented to
+	// and accepted by users deploying VMs from the library item.
+	// This field is populated only if the library item is of the "Ovf" type.
+	// +listType=atomic
+	// +optional
+	EULAs []EULA `json:"eulas,omitempty"`
+
+	// IsoInfo describes the metadata extracted from the ISO image.
+	// This field is populated only if the library item is of the "Iso" type.
+	// +optional
+	IsoInfo *IsoInfo `json:"isoInfo,omitempty"`
+
+	// StorageURIs lists the datastore URIs of the library item files, e.g.
+	// "ds:///vmfs/volumes/<datastore-uuid>/contentlib-<library-uuid>/<item-uuid>/disk-0.vmdk".
+	// This field is populated only when the library item files are cached.
+	// +listType=set
+	// +optional
+	StorageURIs []string `json:"storageURIs,omitempty"`
+
 	// Cached indicates if the library item files are on disk in vCenter.
+	//
+	// Deprecated: Use CacheStatus, which also reports why the library item files are not on disk.
 	// +required
 	Cached bool `json:"cached"`
 
+	// CacheStatus indicates whether the library item files are on disk in vCenter. It supersedes Cached,
+	// which is true only if CacheStatus is "Cached".
+	// Possible values are "Cached", "NotCached", "Caching", "Evicting" and "Error".
+	// +kubebuilder:validation:Enum=Cached;NotCached;Caching;Evicting;Error
+	// +optional
+	CacheStatus CacheStatus `json:"cacheStatus,omitempty"`
+
+	// CacheReason is a brief CamelCase reason for the CacheStatus, e.g. "NotRequested" or "CachingFailed".
+	// +optional
+	CacheReason string `json:"cacheReason,omitempty"`
+
+	// CacheMessage is a human readable message with details about the CacheStatus, e.g. the error that
+	// caused caching to fail.
+	// +optional
+	CacheMessage string `json:"cacheMessage,omitempty"`
+
 	// Ready denotes that the library item is ready to be used.
 	// +required
 	Ready bool `json:"ready"`
 
+	// Phase indicates the lifecycle phase of the library item.
+	// Possible values are "Available" and "Orphaned".
+	// +optional
+	Phase ContentLibraryItemPhase `json:"phase,omitempty"`
+
 	// CreationTime indicates the date and time when this library item was created.
 	// +required
 	CreationTime string `json:"creationTime"`
@@ -67,14 +213,88 @@ type ClusterContentLibraryItemStatus struct {
 	// This field applies only to subscribed library items.
 	// +optional
 	LastSyncTime string `json:"lastSyncTime,omitempty"`
+
+	// SyncProgress describes the progress of the ongoing transfer of the library item content.
+	// This field is populated only while the Transferring condition is true.
+	// +optional
+	SyncProgress *SyncProgress `json:"syncProgress,omitempty"`
+
+	// Signatures describes the signatures found at Spec.AttestationRef.
+	// +listType=atomic
+	// +optional
+	Signatures []SignatureInfo `json:"signatures,omitempty"`
+
+	// LastTransferThroughput is the average rate, in bytes per second, of the last completed transfer of the library item content.
+	// +optional
+	LastTransferThroughput int64 `json:"lastTransferThroughput,omitempty"`
+
+	// LastUsedTime indicates the time when the content of the library item was last used, e.g. to deploy
+	// a VM. It drives the eviction of the cached content of the item.
+	// +optional
+	LastUsedTime *metav1.Time `json:"lastUsedTime,omitempty"`
+
+	// DeployCount is the number of VMs deployed from the library item. It is incremented by the consumers of
+	// the library item, e.g. vm-operator, with RecordDeploy, so that unused images can be identified.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DeployCount int64 `json:"deployCount,omitempty"`
+
+	// LastDeployTime indicates the time when a VM was last deployed from the library item. It is set by the
+	// consumers of the library item together with DeployCount.
+	// +optional
+	LastDeployTime *metav1.Time `json:"lastDeployTime,omitempty"`
+
+	// LastObservedTime indicates the time when the controller last reconciled the library item. It is updated on
+	// every reconciliation, even when nothing changed, so that a wedged controller can be detected.
+	// +optional
+	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
+
+	// LastDriftCheckTime indicates the time when the library item was last compared with vCenter to detect
+	// out-of-band changes.
+	// +optional
+	LastDriftCheckTime *metav1.Time `json:"lastDriftCheckTime,omitempty"`
+
+	// LastTaskInfo describes the last vCenter task that failed for this library item.
+	// +optional
+	LastTaskInfo *TaskInfo `json:"lastTaskInfo,omitempty"`
+
+	// LastError describes the last error encountered while reconciling the library item, and whether it is retried.
+	// This field is cleared once the library item is reconciled successfully.
+	// +optional
+	LastError *ErrorInfo `json:"lastError,omitempty"`
+
+	// Summary is a one-line human readable summary of the state of the ClusterContentLibraryItem, e.g. "Ready" or
+	// "Error: publisher certificate expired", derived from its conditions by conditions.ReadyMessage.
+	// +optional
+	Summary string `json:"summary,omitempty"`
+
+	// Conditions describes the current condition information of the ClusterContentLibraryItem.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (clusterContentLibraryItem *ClusterContentLibraryItem) GetConditions() Conditions {
+	return clusterContentLibraryItem.Status.Conditions
+}
+
+func (clusterContentLibraryItem *ClusterContentLibraryItem) SetConditions(conditions Conditions) {
+	clusterContentLibraryItem.Status.Conditions = conditions
 }
 
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,shortName=cclitem
+// +kubebuilder:resource:scope=Cluster,shortName=cclitem;clusterlibitem,categories=imageregistry;vmware
 // +kubebuilder:printcolumn:name="ClusterContentLibraryRef",type="string",JSONPath=".status.clusterContentLibraryRef"
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".status.type"
+// +kubebuilder:printcolumn:name="SourceLibraryType",type="string",priority=1,JSONPath=".status.sourceLibraryType"
 // +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+// +kubebuilder:printcolumn:name="Size",type="integer",JSONPath=".status.size"
+// +kubebuilder:printcolumn:name="ContentVersion",type="string",JSONPath=".status.contentVersion"
+// +kubebuilder:printcolumn:name="Phase",type="string",priority=1,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Summary",type="string",priority=1,JSONPath=".status.summary"
+// +kubebuilder:printcolumn:name="Deploys",type="integer",priority=1,JSONPath=".status.deployCount"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="LastSyncTime",type="string",JSONPath=".status.lastSyncTime"
 
@@ -0,0 +1,47 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=cclitemsummary,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="TotalItems",type="integer",JSONPath=".status.totalItems"
+// +kubebuilder:printcolumn:name="ReadyItems",type="integer",JSONPath=".status.readyItems"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterContentLibraryItemSummary is the schema for the content library item summary API at the cluster scope.
+// The content library operator maintains one ClusterContentLibraryItemSummary per ClusterContentLibrary, with
+// the same name as the library.
+type ClusterContentLibraryItemSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status ItemSummaryStatus `json:"status,omitempty"`
+}
+
+func (summary *ClusterContentLibraryItemSummary) GetConditions() Conditions {
+	return summary.Status.Conditions
+}
+
+func (summary *ClusterContentLibraryItemSummary) SetConditions(conditions Conditions) {
+	summary.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterContentLibraryItemSummaryList contains a list of ClusterContentLibraryItemSummary.
+type ClusterContentLibraryItemSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterContentLibraryItemSummary `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ClusterContentLibraryItemSummary{}, &ClusterContentLibraryItemSummaryList{})
+}
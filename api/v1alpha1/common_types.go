@@ -0,0 +1,165 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionReady indicates whether a resource fulfills its purpose, e.g. a library item whose content can
+	// be used or a ContentLibraryItemImportRequest whose image was imported. Every kind of this API reports
+	// it, so that "kubectl wait --for=condition=Ready" works uniformly. Its controller sets it last in every
+	// reconciliation, from the other conditions of the resource, with conditions.SetReady: it is True when
+	// all of them are, and otherwise has the reason, severity and message of the first one that is not.
+	// For library items it is True if and only if Status.Ready is true.
+	ConditionReady = ConditionType("Ready")
+
+	// ConditionStale indicates that the controller did not observe the resource within the stale threshold
+	// of the ContentLibraryConfiguration, e.g. because the content library operator is wedged. It is set by
+	// a component other than the controller of the resource, based on Status.LastObservedTime.
+	ConditionStale = ConditionType("Stale")
+
+	// NotObservedReason documents that the resource was not observed by its controller within the stale
+	// threshold.
+	NotObservedReason = "NotObserved"
+)
+
+// TaskInfo describes a vCenter task so that Kubernetes status can be correlated with the vCenter task logs.
+type TaskInfo struct {
+	// ID is the managed object reference of the vCenter task, e.g. "task-1234".
+	// +required
+	ID string `json:"id"`
+
+	// OperationID is the vCenter operation ID (opID) of the request that started the task.
+	// +optional
+	OperationID string `json:"operationID,omitempty"`
+
+	// Description is a human-readable description of the task.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// ErrorMessage is the error reported by vCenter for the task.
+	// +optional
+	ErrorMessage string `json:"errorMessage,omitempty"`
+
+	// QueueTime indicates the time when the task was queued in vCenter.
+	// +optional
+	QueueTime *metav1.Time `json:"queueTime,omitempty"`
+
+	// StartTime indicates the time when the task was started in vCenter.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime indicates the time when the task completed in vCenter.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// ErrorInfo describes the last error a controller encountered while reconciling a resource, and its retry state.
+type ErrorInfo struct {
+	// Time indicates when the error occurred.
+	// +required
+	Time metav1.Time `json:"time"`
+
+	// Operation is the operation that failed, e.g. "Sync" or "Import".
+	// +required
+	Operation string `json:"operation"`
+
+	// Message is a human-readable description of the error.
+	// +required
+	Message string `json:"message"`
+
+	// Retryable indicates whether the controller retries the operation automatically. If false, the error
+	// requires intervention.
+	// +required
+	Retryable bool `json:"retryable"`
+
+	// RetryCount is the number of times the operation was retried since it first failed.
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty"`
+
+	// NextRetryTime indicates when the controller retries the operation next.
+	// This field is populated only if Retryable is true.
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+}
+
+// NameAndKindRef refers to an object of another API by its kind and name, e.g. a VirtualMachineImage.
+// A namespaced object must be in the namespace of the referring resource.
+type NameAndKindRef struct {
+	// APIVersion is the group and version of the API of the object, e.g. "vmoperator.vmware.com/v1alpha1".
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind is the kind of the object, e.g. "VirtualMachineImage".
+	// +required
+	Kind string `json:"kind"`
+
+	// Name is the name of the object.
+	// +required
+	Name string `json:"name"`
+}
+
+// LibraryItemReference refers to a ContentLibraryItem or a ClusterContentLibraryItem.
+type LibraryItemReference struct {
+	// Kind is the kind of the library item.
+	// Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+	// +kubebuilder:validation:Enum=ContentLibraryItem;ClusterContentLibraryItem
+	// +required
+	Kind string `json:"kind"`
+
+	// Name is the name of the library item. A ContentLibraryItem must be in the namespace of the referring
+	// resource.
+	// +required
+	Name string `json:"name"`
+}
+
+// ItemFileReference refers to a file of a library item, optionally at a given content version, e.g. the ISO
+// image of an "Iso" library item attached to a VM as a CD-ROM. It is meant to be embedded by the APIs of other
+// projects, such as the CD-ROM API of vm-operator, so that references to library item files are expressed
+// identically across projects.
+type ItemFileReference struct {
+	// ItemRef refers to the library item.
+	// +required
+	ItemRef LibraryItemReference `json:"itemRef"`
+
+	// FileName is the name of the file of the library item, as reported by its Status.Files. If unset, the
+	// library item must have exactly one file, e.g. the ISO image of an "Iso" library item.
+	// +optional
+	FileName string `json:"fileName,omitempty"`
+
+	// ContentVersion is the content version of the library item the file must be at, as reported by its
+	// Status.ContentVersion. If unset, the current content of the library item is referred to.
+	// +optional
+	ContentVersion string `json:"contentVersion,omitempty"`
+}
+
+// ProviderCapabilities describes the content library features supported by the vCenter a library belongs to,
+// derived from the version of the vCenter, so that consumers can check whether a feature is available for a
+// library instead of failing at runtime against older vCenters.
+type ProviderCapabilities struct {
+	// VCenterVersion is the version of the vCenter the capabilities were derived from, e.g. "8.0.1".
+	// +optional
+	VCenterVersion string `json:"vCenterVersion,omitempty"`
+
+	// VCenterBuild is the build number of the vCenter the capabilities were derived from, e.g. "21560480".
+	// +optional
+	VCenterBuild string `json:"vCenterBuild,omitempty"`
+
+	// SupportsSecurityPolicies indicates whether security policies, such as OVF signature verification, can
+	// be applied to the library.
+	// +optional
+	SupportsSecurityPolicies bool `json:"supportsSecurityPolicies,omitempty"`
+
+	// SupportsOVFMultiSite indicates whether the OVF templates of the library can be synchronized to
+	// subscribed libraries of other vCenters, e.g. of another site.
+	// +optional
+	SupportsOVFMultiSite bool `json:"supportsOVFMultiSite,omitempty"`
+
+	// MaxItemSize is the maximum size of the content of a library item supported by the vCenter. If unset,
+	// the size is not limited.
+	// +optional
+	MaxItemSize *resource.Quantity `json:"maxItemSize,omitempty"`
+}
@@ -59,6 +59,13 @@ type Condition struct {
 	// +optional
 	Severity ConditionSeverity `json:"severity,omitempty"`
 
+	// ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+	// clients can tell whether the condition reflects the current spec of the resource. It is only set by
+	// controllers that track it, e.g. for the Ready condition.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// Last time the condition transitioned from one status to another.
 	// This should be when the underlying condition changed. If that is not known, then using the time when
 	// the API field changed is acceptable.
@@ -78,4 +85,8 @@ type Condition struct {
 }
 
 // Conditions provide observations of the operational state of a VM Operator API resource.
+// Conditions are merged by type, so that controllers owning different conditions of a resource can apply
+// them independently with server-side apply.
+// +listType=map
+// +listMapKey=type
 type Conditions []Condition
@@ -3,6 +3,8 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -17,6 +19,72 @@ const (
 	ContentLibraryTypeSubscribed = ContentLibraryType("Subscribed")
 )
 
+const (
+	// ContentLibraryConditionItemsReady indicates whether all the library items of a library are ready.
+	ContentLibraryConditionItemsReady = ConditionType("ItemsReady")
+
+	// ItemsNotReadyReason documents that one or more library items of a library are not ready.
+	ItemsNotReadyReason = "ItemsNotReady"
+
+	// ContentLibraryConditionVCenterConnected indicates whether the operator can connect to the vCenter
+	// the library belongs to. It is set on ContentLibrary and ClusterContentLibrary resources, so that
+	// connection and credential issues surface on the affected resources.
+	ContentLibraryConditionVCenterConnected = ConditionType("VCenterConnected")
+
+	// ContentLibraryConditionDriftDetected indicates that the library was changed in vCenter out-of-band and
+	// no longer matches its spec.
+	ContentLibraryConditionDriftDetected = ConditionType("DriftDetected")
+
+	// ContentLibraryConditionDegraded indicates that the synchronization of the library is degraded, e.g.
+	// because it failed more times in a row than allowed by the sync failure policy of the library.
+	ContentLibraryConditionDegraded = ConditionType("Degraded")
+
+	// SyncFailureBudgetExceededReason documents that the synchronization of the library failed more times in
+	// a row than SyncFailurePolicy.MaxConsecutiveFailures.
+	SyncFailureBudgetExceededReason = "SyncFailureBudgetExceeded"
+)
+
+// The reasons of the VCenterConnected condition of libraries.
+const (
+	// InvalidCredentialsReason documents that vCenter rejected the credentials of the operator, e.g. because
+	// they were rotated.
+	InvalidCredentialsReason = "InvalidCredentials"
+
+	// CertificateUntrustedReason documents that the certificate presented by vCenter is not trusted.
+	CertificateUntrustedReason = "CertificateUntrusted"
+
+	// TimeoutReason documents that vCenter did not respond in time.
+	TimeoutReason = "Timeout"
+
+	// PermissionDeniedReason documents that the operator is authenticated but lacks the privileges to
+	// access the library in vCenter.
+	PermissionDeniedReason = "PermissionDenied"
+)
+
+// The reasons of the DriftDetected condition of libraries and library items.
+const (
+	// NameDriftReason documents that the name in vCenter differs from the desired name.
+	NameDriftReason = "NameDrift"
+
+	// DescriptionDriftReason documents that the description in vCenter differs from the desired description.
+	DescriptionDriftReason = "DescriptionDrift"
+
+	// WritabilityDriftReason documents that the library in vCenter no longer supports the desired writability,
+	// e.g. because the library was converted to a subscribed library.
+	WritabilityDriftReason = "WritabilityDrift"
+
+	// SecurityPolicyDriftReason documents that the security policy of the library in vCenter was changed.
+	SecurityPolicyDriftReason = "SecurityPolicyDrift"
+)
+
+// VCenterReference refers to the vCenter connection a library belongs to.
+type VCenterReference struct {
+	// Name is the name of the vCenter connection, e.g. a Secret holding the vCenter endpoint and credentials,
+	// in the namespace of the content library operator.
+	// +required
+	Name string `json:"name"`
+}
+
 // StorageBackingType is a constant type that indicates the type of the storage backing for a content library in vCenter.
 type StorageBackingType string
 
@@ -27,12 +95,16 @@ const (
 	// StorageBackingTypeOther indicates a remote file system backed content library in vCenter.
 	// Supports NFS and SMB remote file systems.
 	StorageBackingTypeOther = StorageBackingType("Other")
+
+	// StorageBackingTypeDatastoreCluster indicates a content library in vCenter backed by a datastore cluster
+	// (storage pod), whose datastore is selected by Storage DRS.
+	StorageBackingTypeDatastoreCluster = StorageBackingType("DatastoreCluster")
 )
 
 // StorageBacking describes the default storage backing which is available for the library.
 type StorageBacking struct {
 	// Type indicates the type of storage where the content would be stored.
-	// Possible values are "Datastore" and "Other".
+	// Possible values are "Datastore", "DatastoreCluster" and "Other".
 	// +required
 	Type StorageBackingType `json:"type"`
 
@@ -40,12 +112,27 @@ type StorageBacking struct {
 	// in the library for the "Datastore" storageType in vCenter.
 	// +optional
 	DatastoreID string `json:"datastoreID,omitempty"`
+
+	// DatastoreName indicates the name of the datastore identified by DatastoreID.
+	// +optional
+	DatastoreName string `json:"datastoreName,omitempty"`
+
+	// StoragePodID indicates the identifier of the datastore cluster used to store the content in the library
+	// for the "DatastoreCluster" storageType in vCenter, e.g. "group-p123". DatastoreID then indicates the
+	// datastore of the cluster selected by Storage DRS, if known.
+	// +optional
+	StoragePodID string `json:"storagePodID,omitempty"`
+
+	// StoragePodName indicates the name of the datastore cluster identified by StoragePodID.
+	// +optional
+	StoragePodName string `json:"storagePodName,omitempty"`
 }
 
 // SubscriptionInfo defines how the subscribed library synchronizes to a remote source.
 type SubscriptionInfo struct {
 	// SubscriptionURL is the URL of the endpoint where the metadata for the remotely published library is being served.
 	// The value from PublishInfo.PublishURL of the published library should be used while creating a subscribed library.
+	// +kubebuilder:validation:XValidation:rule="self.matches('^https?://')",message="must be an http or https URL"
 	// +required
 	SubscriptionURL string `json:"subscriptionURL"`
 
@@ -58,6 +145,150 @@ type SubscriptionInfo struct {
 	AutomaticSyncEnabled bool `json:"automaticSyncEnabled"`
 }
 
+const (
+	// SubscriptionPasswordSecretKey is the key in the subscription password Secret that holds the password of
+	// the published library.
+	SubscriptionPasswordSecretKey = "password"
+)
+
+// SubscriptionSpec defines the desired subscription of a subscribed library. The content library operator
+// applies it to the subscribed library in vCenter whenever it changes.
+type SubscriptionSpec struct {
+	// SubscriptionURL is the URL of the endpoint where the metadata for the remotely published library is being served.
+	// The value from PublishInfo.PublishURL of the published library should be used.
+	// +kubebuilder:validation:XValidation:rule="self.matches('^https?://')",message="must be an http or https URL"
+	// +required
+	SubscriptionURL string `json:"subscriptionURL"`
+
+	// OnDemand indicates whether a library item’s content will be synchronized only on demand.
+	// +required
+	OnDemand bool `json:"onDemand"`
+
+	// AutomaticSyncEnabled indicates whether the library should participate in automatic library synchronization.
+	// +required
+	AutomaticSyncEnabled bool `json:"automaticSyncEnabled"`
+
+	// PasswordSecretRef refers to a Secret in the namespace of the library whose "password" key holds the
+	// password of the published library, used with HTTP basic authentication. If unset, the library subscribes
+	// without authentication.
+	// +optional
+	PasswordSecretRef *corev1.LocalObjectReference `json:"passwordSecretRef,omitempty"`
+
+	// SSLThumbprint is the SHA-1 thumbprint of the certificate presented by the subscription URL, e.g.
+	// "AB:CD:...:EF", which vCenter trusts when the certificate is not signed by a trusted authority.
+	// +kubebuilder:validation:XValidation:rule="self.matches('^([0-9A-Fa-f]{2}:){19}[0-9A-Fa-f]{2}$')",message="must be a SHA-1 thumbprint of the form XX:XX:...:XX"
+	// +optional
+	SSLThumbprint string `json:"sslThumbprint,omitempty"`
+}
+
+const (
+	// ProxyCredentialsUsernameKey is the key in the proxy credentials Secret that holds the proxy user name.
+	ProxyCredentialsUsernameKey = "username"
+
+	// ProxyCredentialsPasswordKey is the key in the proxy credentials Secret that holds the proxy password.
+	ProxyCredentialsPasswordKey = "password"
+)
+
+// ProxyConfiguration describes the proxy a subscribed library uses to reach its subscription URL.
+type ProxyConfiguration struct {
+	// HTTPProxy is the URL of the proxy server used for HTTP requests, e.g. "http://proxy.example.com:3128".
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the URL of the proxy server used for HTTPS requests.
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is a list of host names, domain suffixes, IP addresses or CIDRs that must be reached directly
+	// instead of through the proxy.
+	// +listType=set
+	// +optional
+	NoProxy []string `json:"noProxy,omitempty"`
+
+	// CredentialsSecretRef refers to a Secret containing the "username" and "password" keys used to
+	// authenticate against the proxy. If the namespace is omitted, the namespace of the library is assumed.
+	// +optional
+	CredentialsSecretRef *corev1.SecretReference `json:"credentialsSecretRef,omitempty"`
+}
+
+// SyncFailurePolicy describes how the content library operator retries the failed synchronizations of a
+// library, so that a failing publisher does not cause a hot retry loop against vCenter.
+type SyncFailurePolicy struct {
+	// MaxConsecutiveFailures is the number of synchronizations that can fail in a row before the library is
+	// marked Degraded. Synchronizations are still retried once the library is degraded, with the maximum
+	// backoff.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConsecutiveFailures int32 `json:"maxConsecutiveFailures,omitempty"`
+
+	// MaxBackoff is the maximum delay between two retries of a failed synchronization. The delay grows
+	// exponentially with the number of consecutive failures up to MaxBackoff.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+// CacheEvictionPolicy describes when the cached content of the library items of a library is evicted from
+// the storage backing of the library. An item is evicted when either limit is exceeded, least recently used
+// first. Items with an active lease are never evicted.
+type CacheEvictionPolicy struct {
+	// MaxUnusedDuration is the duration after which the content of an item that was not used is evicted,
+	// e.g. "720h" for 30 days.
+	// +optional
+	MaxUnusedDuration *metav1.Duration `json:"maxUnusedDuration,omitempty"`
+
+	// MaxCacheSize is the maximum total size of the cached content of the library items. When it is
+	// exceeded, the content of the least recently used items is evicted.
+	// +optional
+	MaxCacheSize *resource.Quantity `json:"maxCacheSize,omitempty"`
+}
+
+// PublishAuthenticationMethod is a constant type that indicates how subscribers authenticate to a published library.
+type PublishAuthenticationMethod string
+
+const (
+	// PublishAuthenticationMethodNone indicates that subscribers do not need to authenticate.
+	PublishAuthenticationMethodNone = PublishAuthenticationMethod("None")
+
+	// PublishAuthenticationMethodBasic indicates that subscribers authenticate with HTTP basic authentication,
+	// using the "vcsp" user name and the password of the published library.
+	PublishAuthenticationMethodBasic = PublishAuthenticationMethod("Basic")
+
+	// PublishPasswordSecretKey is the key in the publish password Secret that holds the password.
+	PublishPasswordSecretKey = "password"
+)
+
+// PublishAuthentication defines how subscribers authenticate to a published library.
+// +kubebuilder:validation:XValidation:rule="self.method != 'Basic' || has(self.passwordSecretRef)",message="passwordSecretRef is required for Basic authentication"
+type PublishAuthentication struct {
+	// Method is the authentication method subscribers must use.
+	// Possible values are "None" and "Basic".
+	// +kubebuilder:validation:Enum=None;Basic
+	// +required
+	Method PublishAuthenticationMethod `json:"method"`
+
+	// PasswordSecretRef refers to a Secret in the namespace of the library whose "password" key holds the
+	// password of the published library. This field is required if Method is "Basic".
+	// +optional
+	PasswordSecretRef *corev1.LocalObjectReference `json:"passwordSecretRef,omitempty"`
+}
+
+// PublishSpec defines the desired publication of a local library.
+type PublishSpec struct {
+	// Enabled indicates whether the library is published so that it can be subscribed to.
+	// +required
+	Enabled bool `json:"enabled"`
+
+	// Authentication defines how subscribers authenticate to the published library.
+	// If unset, subscribers do not need to authenticate.
+	// +optional
+	Authentication *PublishAuthentication `json:"authentication,omitempty"`
+
+	// PersistJSONEnabled indicates whether the library and library item metadata are persisted as JSON files
+	// on the storage backing of the library, so the library can be published from the storage directly.
+	// +optional
+	PersistJSONEnabled bool `json:"persistJSONEnabled,omitempty"`
+}
+
 // PublishInfo defines how the library is published so that it can be subscribed to by a remote subscribed library.
 type PublishInfo struct {
 	// Published indicates if the local library is published.
@@ -66,19 +297,228 @@ type PublishInfo struct {
 
 	// PublishURL is the URL to which the library metadata is published by the vSphere Content Library Service.
 	// This value can be used to set the SubscriptionInfo.subscriptionURL property when creating a subscribed library.
+	// +kubebuilder:validation:XValidation:rule="self == '' || self.matches('^https?://')",message="must be an http or https URL"
 	// +required
 	PublishURL string `json:"publishURL"`
+
+	// PersistJSONEnabled reports whether vCenter persists the library and library item metadata of the library
+	// as JSON files on its storage backing, as observed in the publish settings of the library in vCenter. It
+	// may differ from Spec.Publish.PersistJSONEnabled until the operator applied the spec.
+	// +optional
+	PersistJSONEnabled bool `json:"persistJSONEnabled,omitempty"`
+
+	// SubscriptionCount is the number of subscribed libraries, in this vCenter or remote ones, that subscribe
+	// to the published library.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SubscriptionCount int32 `json:"subscriptionCount,omitempty"`
+}
+
+// Permission describes a vCenter principal that holds a role granting privileges to modify a library,
+// either on the library itself or inherited from a parent object in vCenter.
+type Permission struct {
+	// Principal is the name of the vCenter user or group, e.g. "Administrators@vsphere.local".
+	// +required
+	Principal string `json:"principal"`
+
+	// Group indicates whether the principal is a group.
+	// +optional
+	Group bool `json:"group,omitempty"`
+
+	// Role is the name of the vCenter role held by the principal.
+	// +required
+	Role string `json:"role"`
+
+	// Inherited indicates whether the permission is inherited from a parent object of the library in vCenter.
+	// +optional
+	Inherited bool `json:"inherited,omitempty"`
+}
+
+// PermissionsInfo summarizes the vCenter principals that can modify a library.
+type PermissionsInfo struct {
+	// Entries are the vCenter principals that can modify the library, sorted by principal.
+	// +listType=atomic
+	// +optional
+	Entries []Permission `json:"entries,omitempty"`
+
+	// LastRefreshTime indicates the time when the permissions were last read from vCenter.
+	// +optional
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+}
+
+// DeletionPolicy is a constant type that indicates what happens to a library in vCenter when its
+// ContentLibrary is deleted.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyRetain indicates that the library is left in vCenter, and only released from management,
+	// when its ContentLibrary is deleted.
+	DeletionPolicyRetain = DeletionPolicy("Retain")
+
+	// DeletionPolicyDelete indicates that the library and its content are deleted in vCenter when its
+	// ContentLibrary is deleted.
+	DeletionPolicyDelete = DeletionPolicy("Delete")
+)
+
+// ItemMetadataTemplate describes the labels and annotations the content library operator sets on the
+// library item resources of a library when it synchronizes them. Keys and values are Go templates rendered
+// with the ItemMetadataVariables of each library item, e.g. "{{.Type}}" or "os-{{.ItemName}}". No two keys may
+// render to the same key.
+type ItemMetadataTemplate struct {
+	// Labels are the labels set on the library item resources. The labels with the imageregistry.vmware.com/
+	// prefix, once rendered, are reserved for the well-known labels and cannot be set.
+	// +mapType=granular
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are the annotations set on the library item resources. The annotations with the
+	// imageregistry.vmware.com/ prefix, once rendered, are reserved and cannot be set.
+	// +mapType=granular
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ContentLibraryCreateSpec describes a library that the operator creates in vCenter.
+// +kubebuilder:validation:XValidation:rule="self.type == 'Subscribed' || !has(self.subscription)",message="subscription can only be set for a library of the Subscribed type"
+type ContentLibraryCreateSpec struct {
+	// Name is the name of the library created in vCenter.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// Description is the description of the library created in vCenter.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Type is the type of the library created in vCenter.
+	// Possible types are "Local" and "Subscribed".
+	// +kubebuilder:validation:Enum=Local;Subscribed
+	// +required
+	Type ContentLibraryType `json:"type"`
+
+	// StorageBacking is the storage backing of the library created in vCenter. If unset, the datastore is
+	// selected from Spec.StoragePolicyID or Spec.StorageClassName.
+	// +optional
+	StorageBacking *StorageBacking `json:"storageBacking,omitempty"`
+
+	// Subscription defines how the created library synchronizes to a remote source. Either this field or
+	// Spec.Subscription is required if Type is "Subscribed". The publication of a created library of the
+	// "Local" type is set with Spec.Publish.
+	//
+	// Deprecated: Use Spec.Subscription, which can also be changed after the library is created.
+	// +optional
+	Subscription *SubscriptionInfo `json:"subscription,omitempty"`
 }
 
 // ContentLibrarySpec defines the desired state of a ContentLibrary.
+// A ContentLibrary either adopts an existing library in vCenter, identified by UUID, or has the operator
+// create a new library described by Create. Exactly one of the two fields must be set, and a ContentLibrary
+// cannot switch between the two modes.
+// +kubebuilder:validation:XValidation:rule="has(self.uuid) != has(self.create)",message="exactly one of uuid and create must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.create) == has(oldSelf.create)",message="a library cannot switch between being adopted and being created"
+// +kubebuilder:validation:XValidation:rule="!has(self.deletionPolicy) || self.deletionPolicy == 'Retain' || has(self.create)",message="deletionPolicy Delete can only be set for a library created by the operator"
+// +kubebuilder:validation:XValidation:rule="!has(self.create) || self.create.type != 'Subscribed' || has(self.create.subscription) || has(self.subscription)",message="subscription is required for a library of the Subscribed type"
+// +kubebuilder:validation:XValidation:rule="!has(self.create) || !has(self.subscription) || self.create.type == 'Subscribed'",message="subscription can only be set for a library of the Subscribed type"
+// +kubebuilder:validation:XValidation:rule="!has(self.create) || !has(self.create.subscription) || !has(self.subscription)",message="subscription and create.subscription are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.create) || self.create.type != 'Subscribed' || !self.writable",message="a library of the Subscribed type cannot be writable"
+// +kubebuilder:validation:XValidation:rule="!has(self.create) || !has(self.publish) || self.create.type == 'Local'",message="only a library of the Local type can be published"
+// +kubebuilder:validation:XValidation:rule="has(self.vCenterRef) == has(oldSelf.vCenterRef)",message="vCenterRef is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.storagePolicyID) == has(oldSelf.storagePolicyID)",message="storagePolicyID is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.storageClassName) == has(oldSelf.storageClassName)",message="storageClassName is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.storagePolicyID) || !has(self.storageClassName)",message="storagePolicyID and storageClassName are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.storagePolicyID) && !has(self.storageClassName) || has(self.create) && self.writable",message="storagePolicyID and storageClassName can only be set for a writable library created by the operator"
 type ContentLibrarySpec struct {
 	// UUID is the identifier which uniquely identifies the library in vCenter. This field is immutable.
-	// +required
-	UUID string `json:"uuid"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="uuid is immutable"
+	// +optional
+	UUID LibraryID `json:"uuid,omitempty"`
+
+	// Create describes the library that the operator creates in vCenter. The UUID of the created library is
+	// reported in Status.UUID. This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="create is immutable"
+	// +optional
+	Create *ContentLibraryCreateSpec `json:"create,omitempty"`
+
+	// VCenterRef refers to the vCenter the library belongs to. If unset, the default vCenter of the
+	// supervisor is assumed. This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vCenterRef is immutable"
+	// +optional
+	VCenterRef *VCenterReference `json:"vCenterRef,omitempty"`
 
 	// Writable flag indicates if the users can create new library items in this library.
 	// +required
 	Writable bool `json:"writable"`
+
+	// Subscription defines the desired subscription of the library, and Status.SubscriptionInfo reports the
+	// subscription in vCenter. Changes are applied to the library in vCenter. If unset, the subscription is not
+	// managed from Kubernetes.
+	// This field applies only if the library is of the "Subscribed" type.
+	// +optional
+	Subscription *SubscriptionSpec `json:"subscription,omitempty"`
+
+	// Publish defines the desired publication of the library, and Status.PublishInfo reports the resulting
+	// publish URL. If unset, the publication is not managed from Kubernetes.
+	// This field applies only if the library is of the "Local" type.
+	// +optional
+	Publish *PublishSpec `json:"publish,omitempty"`
+
+	// Proxy specifies the proxy used to reach the subscription URL of the library. If unset, the vCenter-wide
+	// proxy settings apply. This field applies only if the library is of the "Subscribed" type.
+	// +optional
+	Proxy *ProxyConfiguration `json:"proxy,omitempty"`
+
+	// ItemMetadataTemplate describes the labels and annotations set on the ContentLibraryItems of the
+	// library, so that catalog-wide labeling policies do not require a separate mutating controller.
+	// Changes are applied to the existing items at the next synchronization.
+	// +optional
+	ItemMetadataTemplate *ItemMetadataTemplate `json:"itemMetadataTemplate,omitempty"`
+
+	// SyncFailurePolicy describes how the failed synchronizations of the library are retried. If unset, the
+	// defaults of the content library operator apply.
+	// This field applies only if the library is of the "Subscribed" type.
+	// +optional
+	SyncFailurePolicy *SyncFailurePolicy `json:"syncFailurePolicy,omitempty"`
+
+	// TransferRateLimit is the maximum rate, in bytes per second, at which the content of the library items
+	// is transferred when the library is synchronized, e.g. "100Mi". If unset, transfers are not limited.
+	// +optional
+	TransferRateLimit *resource.Quantity `json:"transferRateLimit,omitempty"`
+
+	// ResyncIntervalSeconds is the interval, in seconds, at which the library is reconciled with vCenter. It
+	// overrides the defaultSyncInterval of the ContentLibraryConfiguration for this library, e.g. to re-list
+	// large static libraries less often than rapidly changing ones. If unset, the default interval applies.
+	// +kubebuilder:validation:Minimum=60
+	// +optional
+	ResyncIntervalSeconds *int32 `json:"resyncIntervalSeconds,omitempty"`
+
+	// CacheEvictionPolicy describes when the cached content of the library items is evicted to reclaim space.
+	// If unset, content is never evicted. This field applies only if the library is of the "Subscribed" type
+	// and synchronizes on demand.
+	// +optional
+	CacheEvictionPolicy *CacheEvictionPolicy `json:"cacheEvictionPolicy,omitempty"`
+
+	// StoragePolicyID is the identifier of the vCenter storage policy (SPBM) used to select the datastore of
+	// a writable library created by the operator. It can only be set if Create is set and Writable is true,
+	// and is mutually exclusive with StorageClassName. This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="storagePolicyID is immutable"
+	// +optional
+	StoragePolicyID string `json:"storagePolicyID,omitempty"`
+
+	// StorageClassName is the name of a StorageClass of the namespace whose storage policy is used to select
+	// the datastore of a writable library created by the operator. It can only be set if Create is set and
+	// Writable is true, and is mutually exclusive with StoragePolicyID. This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="storageClassName is immutable"
+	// +optional
+	StorageClassName string `json:"storageClassName,omitempty"`
+
+	// DeletionPolicy indicates whether the library and its content are deleted in vCenter when the
+	// ContentLibrary is deleted, or merely released from management. Defaults to "Retain".
+	// Only a library created by the operator can have the "Delete" policy, and only if the ContentLibrary
+	// has the ConfirmDeleteAnnotationKey annotation.
+	// Possible values are "Retain" and "Delete".
+	// +kubebuilder:validation:Enum=Retain;Delete
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // ContentLibraryStatus defines the observed state of ContentLibrary.
@@ -87,6 +527,17 @@ type ContentLibraryStatus struct {
 	// +required
 	Name string `json:"name"`
 
+	// UUID is the identifier of the library in vCenter, either Spec.UUID or the identifier generated when the
+	// library described by Spec.Create was created.
+	// +optional
+	UUID LibraryID `json:"uuid,omitempty"`
+
+	// VCenterInstanceUUID is the instance UUID of the vCenter the library belongs to.
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self.matches('^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$')",message="must be a UUID of the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+	// +optional
+	VCenterInstanceUUID string `json:"vCenterInstanceUUID,omitempty"`
+
 	// Description is a human-readable description for this library in vCenter.
 	// +optional
 	Description string `json:"description,omitempty"`
@@ -100,6 +551,11 @@ type ContentLibraryStatus struct {
 	// +required
 	StorageBacking StorageBacking `json:"storageBacking"`
 
+	// StoragePolicyID is the identifier of the storage policy the storage backing of the library was resolved
+	// from, either Spec.StoragePolicyID or the policy of Spec.StorageClassName.
+	// +optional
+	StoragePolicyID string `json:"storagePolicyID,omitempty"`
+
 	// Version is a number that can identify metadata changes. This integer value is incremented when the library
 	// properties such as name or description are changed in vCenter.
 	// +required
@@ -129,22 +585,80 @@ type ContentLibraryStatus struct {
 	// +optional
 	LastSyncTime string `json:"lastSyncTime,omitempty"`
 
+	// ResyncIntervalSeconds is the effective interval, in seconds, at which the library is reconciled with
+	// vCenter, i.e. spec.resyncIntervalSeconds if set, or the default interval of the operator otherwise.
+	// +optional
+	ResyncIntervalSeconds int32 `json:"resyncIntervalSeconds,omitempty"`
+
+	// Permissions summarizes the vCenter principals that can modify the library, so that they can be audited
+	// from Kubernetes. It is refreshed periodically.
+	// +optional
+	Permissions *PermissionsInfo `json:"permissions,omitempty"`
+
+	// ProviderCapabilities describes the content library features supported by the vCenter the library
+	// belongs to. It is refreshed when the version of the vCenter changes.
+	// +optional
+	ProviderCapabilities *ProviderCapabilities `json:"providerCapabilities,omitempty"`
+
+	// ConsecutiveFailures is the number of synchronizations of the library that failed in a row. It is reset
+	// when a synchronization succeeds.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// LastObservedTime indicates the time when the controller last reconciled the library. It is updated on
+	// every reconciliation, even when nothing changed, so that a wedged controller can be detected.
+	// +optional
+	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
+
+	// LastDriftCheckTime indicates the time when the library was last compared with vCenter to detect
+	// out-of-band changes.
+	// +optional
+	LastDriftCheckTime *metav1.Time `json:"lastDriftCheckTime,omitempty"`
+
+	// LastTaskInfo describes the last vCenter task that failed for this library.
+	// +optional
+	LastTaskInfo *TaskInfo `json:"lastTaskInfo,omitempty"`
+
+	// LastError describes the last error encountered while reconciling the library, and whether it is retried.
+	// This field is cleared once the library is reconciled successfully.
+	// +optional
+	LastError *ErrorInfo `json:"lastError,omitempty"`
+
+	// Summary is a one-line human readable summary of the state of the ContentLibrary, e.g. "Ready" or
+	// "Error: publisher certificate expired", derived from its conditions by conditions.ReadyMessage.
+	// +optional
+	Summary string `json:"summary,omitempty"`
+
 	// Conditions describes the current condition information of the ContentLibrary.
 	// +optional
-	Conditions Conditions `json:"conditions,omitempty"`
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (contentLibrary *ContentLibrary) GetConditions() Conditions {
+	return contentLibrary.Status.Conditions
+}
+
+func (contentLibrary *ContentLibrary) SetConditions(conditions Conditions) {
+	contentLibrary.Status.Conditions = conditions
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Namespaced,shortName=cl
+// +kubebuilder:resource:scope=Namespaced,shortName=cl;lib,categories=imageregistry;vmware;all
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".status.type"
-// +kubebuilder:printcolumn:name="Writable",type="boolean",JSONPath=".status.writable"
-// +kubebuilder:printcolumn:name="StorageType",type="string",JSONPath=".status.storageBacking.storageType"
+// +kubebuilder:printcolumn:name="Writable",type="boolean",JSONPath=".spec.writable"
+// +kubebuilder:printcolumn:name="StorageType",type="string",JSONPath=".status.storageBacking.type"
+// +kubebuilder:printcolumn:name="Summary",type="string",priority=1,JSONPath=".status.summary"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="LastSyncTime",type="string",JSONPath=".status.lastSyncTime"
 
 // ContentLibrary is the schema for the content library API.
-// Currently, ContentLibrary is immutable to end users.
+// A ContentLibrary either adopts an existing library in vCenter, identified by spec.uuid, or has the operator
+// create a new library described by spec.create, whose UUID is then reported in status.uuid. Users manage the
+// settings of the library through its spec, e.g. whether it is writable, its subscription and publication,
+// and whether the library is deleted in vCenter with the ContentLibrary. Only a library created by the
+// operator can be deleted in vCenter, and the identity and storage of a library cannot change once set.
 type ContentLibrary struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -0,0 +1,127 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ContentLibraryConfigurationName is the name of the ContentLibraryConfiguration singleton.
+	// Resources with any other name are rejected.
+	ContentLibraryConfigurationName = "default"
+
+	// ContentLibraryConfigurationConditionApplied indicates whether the ContentLibraryConfiguration has been
+	// applied by the content library operator.
+	ContentLibraryConfigurationConditionApplied = ConditionType("Applied")
+)
+
+// ContentLibraryConfigurationSpec defines the desired state of the ContentLibraryConfiguration.
+// Unset fields fall back to the defaults of the content library operator.
+type ContentLibraryConfigurationSpec struct {
+	// MaxConcurrentSyncs is the maximum number of libraries and library items that are synchronized concurrently.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentSyncs int32 `json:"maxConcurrentSyncs,omitempty"`
+
+	// DefaultSyncInterval is the interval at which libraries are synchronized with vCenter.
+	// +optional
+	DefaultSyncInterval *metav1.Duration `json:"defaultSyncInterval,omitempty"`
+
+	// VCenterSessionPoolSize is the maximum number of concurrent sessions the operator keeps open to vCenter.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	VCenterSessionPoolSize int32 `json:"vCenterSessionPoolSize,omitempty"`
+
+	// OrphanedItemGracePeriod is how long a library item resource is kept in the "Orphaned" phase after the
+	// library item was deleted in vCenter, before the resource is deleted.
+	// +optional
+	OrphanedItemGracePeriod *metav1.Duration `json:"orphanedItemGracePeriod,omitempty"`
+
+	// MaxInFlightTransfersPerNamespace is the maximum number of ContentLibraryItemImportRequests and
+	// ContentLibraryItemFileUploads a namespace may have in flight, so that a single namespace cannot exhaust
+	// the transfer sessions of vCenter. It can be lowered, but not raised, for a namespace with the
+	// MaxInFlightTransfersAnnotationKey annotation of the namespace.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxInFlightTransfersPerNamespace int32 `json:"maxInFlightTransfersPerNamespace,omitempty"`
+
+	// ItemNamePrefix is the prefix used to derive the names of library item resources from vCenter UUIDs.
+	// Defaults to "clitem" for ContentLibraryItem resources and "cclitem" for ClusterContentLibraryItem resources.
+	// +kubebuilder:validation:MaxLength=32
+	// +optional
+	ItemNamePrefix string `json:"itemNamePrefix,omitempty"`
+
+	// StaleThreshold is how long a library or library item resource can go without being reconciled before it
+	// is marked Stale.
+	// +optional
+	StaleThreshold *metav1.Duration `json:"staleThreshold,omitempty"`
+
+	// Notifications defines which vCenter content library notifications are propagated to the library and
+	// library item resources as conditions and Events. If unset, notifications are not propagated.
+	// +optional
+	Notifications *NotificationPolicy `json:"notifications,omitempty"`
+
+	// FeatureGates enables or disables the named features of the content library operator. The known features
+	// are listed by DefaultFeatureGates.
+	// +mapType=granular
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+}
+
+// ContentLibraryConfigurationStatus defines the observed state of the ContentLibraryConfiguration.
+type ContentLibraryConfigurationStatus struct {
+	// ObservedGeneration is the generation of the ContentLibraryConfiguration that was last applied.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// AppliedConfiguration is the configuration in effect in the content library operator, with all the
+	// defaults resolved.
+	// +optional
+	AppliedConfiguration *ContentLibraryConfigurationSpec `json:"appliedConfiguration,omitempty"`
+
+	// Conditions describes the current condition information of the ContentLibraryConfiguration.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (configuration *ContentLibraryConfiguration) GetConditions() Conditions {
+	return configuration.Status.Conditions
+}
+
+func (configuration *ContentLibraryConfiguration) SetConditions(conditions Conditions) {
+	configuration.Status.Conditions = conditions
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=clconfig,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="Applied",type="string",JSONPath=".status.conditions[?(@.type=='Applied')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default'",message="the ContentLibraryConfiguration must be named default"
+
+// ContentLibraryConfiguration is the schema for the content library configuration API.
+// It is a cluster scoped singleton named "default" that holds the operator-wide settings of the content
+// library operator.
+type ContentLibraryConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContentLibraryConfigurationSpec   `json:"spec,omitempty"`
+	Status ContentLibraryConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContentLibraryConfigurationList contains a list of ContentLibraryConfiguration.
+type ContentLibraryConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContentLibraryConfiguration `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ContentLibraryConfiguration{}, &ContentLibraryConfigurationList{})
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImportSetEntry describes an image listed in the manifest of a ContentLibraryImportSet.
+// The manifest is a YAML or JSON list of entries. Since the manifest is not part of the resource, the entries
+// are validated with validation.ValidateImportSetEntries when the manifest is read.
+type ImportSetEntry struct {
+	// Name is the name of the library item created in vCenter. It must be unique within the manifest.
+	// +required
+	Name string `json:"name"`
+
+	// Description is the description of the library item created in vCenter.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Type is the type of the library item created in vCenter.
+	// Possible types are "Ovf", "Iso" and "File".
+	// +kubebuilder:validation:Enum=Ovf;Iso;File
+	// +required
+	Type ContentLibraryItemType `json:"type"`
+
+	// URL is the HTTP(S) endpoint of the OVF descriptor, OVA or ISO image to import.
+	// +required
+	URL string `json:"url"`
+
+	// Checksum is the expected checksum of the file at URL.
+	// +optional
+	Checksum *FileChecksum `json:"checksum,omitempty"`
+}
+
+// ImportSetManifestSource describes where the manifest of a ContentLibraryImportSet is read from.
+// Exactly one of the sources must be specified.
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.configMapKeyRef)",message="exactly one of url and configMapKeyRef must be set"
+type ImportSetManifestSource struct {
+	// URL is the HTTP(S) endpoint that serves the manifest.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ConfigMapKeyRef selects the key of a ConfigMap in the same namespace that holds the manifest.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// ImportSetEntryStatus describes the observed state of the import of a manifest entry.
+type ImportSetEntryStatus struct {
+	// Name is the name of the manifest entry.
+	// +required
+	Name string `json:"name"`
+
+	// ImportRequestRef is the name of the ContentLibraryItemImportRequest created for the entry.
+	// +optional
+	ImportRequestRef string `json:"importRequestRef,omitempty"`
+
+	// Phase indicates the phase of the import of the entry.
+	// Possible values are "Pending", "Running", "Succeeded" and "Failed".
+	// +optional
+	Phase ImportPhase `json:"phase,omitempty"`
+}
+
+// ContentLibraryImportSetSpec defines the desired state of a ContentLibraryImportSet.
+type ContentLibraryImportSetSpec struct {
+	// Manifest describes where the list of images to import is read from.
+	// +required
+	Manifest ImportSetManifestSource `json:"manifest"`
+
+	// ContentLibraryRef is the name of the writable ContentLibrary in the same namespace that the images are
+	// imported into.
+	// +required
+	ContentLibraryRef string `json:"contentLibraryRef"`
+}
+
+// ContentLibraryImportSetStatus defines the observed state of a ContentLibraryImportSet.
+type ContentLibraryImportSetStatus struct {
+	// Total is the number of entries in the manifest.
+	// +optional
+	Total int32 `json:"total,omitempty"`
+
+	// Succeeded is the number of entries that were imported successfully.
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// Failed is the number of entries whose import failed.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// Entries describes the observed state of the import of each manifest entry.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Entries []ImportSetEntryStatus `json:"entries,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// Conditions describes the current condition information of the ContentLibraryImportSet.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (importSet *ContentLibraryImportSet) GetConditions() Conditions {
+	return importSet.Status.Conditions
+}
+
+func (importSet *ContentLibraryImportSet) SetConditions(conditions Conditions) {
+	importSet.Status.Conditions = conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=climportset,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="ContentLibraryRef",type="string",JSONPath=".spec.contentLibraryRef"
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.total"
+// +kubebuilder:printcolumn:name="Succeeded",type="integer",JSONPath=".status.succeeded"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failed"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ContentLibraryImportSet is the schema for the content library import set API.
+// It imports every image listed in a manifest into a writable content library, by creating and tracking a
+// ContentLibraryItemImportRequest per image.
+type ContentLibraryImportSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContentLibraryImportSetSpec   `json:"spec,omitempty"`
+	Status ContentLibraryImportSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContentLibraryImportSetList contains a list of ContentLibraryImportSet.
+type ContentLibraryImportSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContentLibraryImportSet `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ContentLibraryImportSet{}, &ContentLibraryImportSetList{})
+}
@@ -3,6 +3,8 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -15,8 +17,356 @@ const (
 
 	// ContentLibraryItemTypeIso indicates an ISO content library item in vCenter.
 	ContentLibraryItemTypeIso = ContentLibraryItemType("Iso")
+
+	// ContentLibraryItemTypeFile indicates a content library item in vCenter that holds arbitrary files,
+	// such as scripts or agent bundles.
+	ContentLibraryItemTypeFile = ContentLibraryItemType("File")
+
+	// ContentLibraryItemTypeUnknown indicates a content library item in vCenter whose type is not supported by
+	// this API. The type reported by vCenter is recorded in the RawType field of the status of the item.
+	ContentLibraryItemTypeUnknown = ContentLibraryItemType("Unknown")
+)
+
+const (
+	// ContentLibraryItemConditionTransferring indicates whether the content of the library item is being
+	// transferred, e.g. while a subscribed library item is synchronized.
+	ContentLibraryItemConditionTransferring = ConditionType("Transferring")
+
+	// ContentLibraryItemConditionDriftDetected indicates that the library item was changed in vCenter
+	// out-of-band and no longer matches its spec.
+	ContentLibraryItemConditionDriftDetected = ConditionType("DriftDetected")
+
+	// ContentLibraryItemConditionOrphaned indicates that the library item no longer exists in vCenter.
+	ContentLibraryItemConditionOrphaned = ConditionType("Orphaned")
+
+	// ItemDeletedInVCenterReason documents that the library item was deleted in vCenter.
+	ItemDeletedInVCenterReason = "ItemDeletedInVCenter"
+
+	// ContentLibraryItemConditionSignatureVerified indicates whether the content of the library item was
+	// verified against the signatures or attestations referred to by its spec.attestationRef.
+	ContentLibraryItemConditionSignatureVerified = ConditionType("SignatureVerified")
+
+	// SignatureNotFoundReason documents that no signature was found at the attestation reference.
+	SignatureNotFoundReason = "SignatureNotFound"
+
+	// SignatureInvalidReason documents that a signature does not match the content of the library item or
+	// was not issued by a trusted signer.
+	SignatureInvalidReason = "SignatureInvalid"
+
+	// ContentLibraryItemConditionHardwareVersionSupported indicates whether the hardware version of the OVF
+	// library item is supported by the hosts of the clusters VMs are deployed to.
+	ContentLibraryItemConditionHardwareVersionSupported = ConditionType("HardwareVersionSupported")
+
+	// HardwareVersionUnsupportedReason documents that the hardware version of the library item is newer than
+	// the hardware versions supported by the target clusters.
+	HardwareVersionUnsupportedReason = "HardwareVersionUnsupported"
+
+	// ContentLibraryItemConditionTypeSupported indicates whether the type of the library item in vCenter is
+	// supported by this API. It is false if the type of the item is "Unknown".
+	ContentLibraryItemConditionTypeSupported = ConditionType("TypeSupported")
+
+	// UnknownItemTypeReason documents that vCenter reports a library item type that is not supported by this
+	// API, e.g. a type introduced by a newer vCenter release.
+	UnknownItemTypeReason = "UnknownItemType"
+)
+
+// AttestationType is a constant type that indicates the format of the signatures of a library item.
+type AttestationType string
+
+const (
+	// AttestationTypeCosign indicates cosign signatures stored in an OCI registry.
+	AttestationTypeCosign = AttestationType("Cosign")
+
+	// AttestationTypeNotary indicates Notary v2 signatures stored in an OCI registry.
+	AttestationTypeNotary = AttestationType("Notary")
+)
+
+// AttestationReference refers to the signatures or attestations of the content of a library item.
+type AttestationReference struct {
+	// Type is the format of the signatures.
+	// Possible values are "Cosign" and "Notary".
+	// +kubebuilder:validation:Enum=Cosign;Notary
+	// +required
+	Type AttestationType `json:"type"`
+
+	// Reference is the location of the signatures, e.g. the OCI reference of a cosign signature
+	// "registry.example.com/images/photon:sha256-<digest>.sig".
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Reference string `json:"reference"`
+}
+
+// SignatureInfo describes a signature of the content of a library item.
+type SignatureInfo struct {
+	// Signer identifies the signer, e.g. the subject of the signing certificate or the ID of the signing key.
+	// +required
+	Signer string `json:"signer"`
+
+	// Digest is the digest of the signed content, e.g. "sha256:<hex>".
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// SignedTime indicates the time when the content was signed, if known.
+	// +optional
+	SignedTime *metav1.Time `json:"signedTime,omitempty"`
+
+	// Verified indicates whether the signature was verified against the content of the library item.
+	// +required
+	Verified bool `json:"verified"`
+
+	// Message describes why the signature could not be verified.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ContentLibraryItemPhase is a constant type that indicates the lifecycle phase of a library item resource.
+type ContentLibraryItemPhase string
+
+const (
+	// ContentLibraryItemPhaseAvailable indicates that the library item exists in vCenter.
+	ContentLibraryItemPhaseAvailable = ContentLibraryItemPhase("Available")
+
+	// ContentLibraryItemPhaseOrphaned indicates that the library item was deleted in vCenter. The resource is
+	// kept for the orphaned item grace period of the ContentLibraryConfiguration before it is deleted.
+	ContentLibraryItemPhaseOrphaned = ContentLibraryItemPhase("Orphaned")
 )
 
+// CacheStatus is a constant type that indicates whether the files of a library item are on disk in vCenter.
+type CacheStatus string
+
+const (
+	// CacheStatusCached indicates that the library item files are on disk in vCenter.
+	CacheStatusCached = CacheStatus("Cached")
+
+	// CacheStatusNotCached indicates that the library item files are not on disk in vCenter.
+	CacheStatusNotCached = CacheStatus("NotCached")
+
+	// CacheStatusCaching indicates that the library item files are being transferred to disk in vCenter.
+	CacheStatusCaching = CacheStatus("Caching")
+
+	// CacheStatusEvicting indicates that the library item files are being removed from disk in vCenter.
+	CacheStatusEvicting = CacheStatus("Evicting")
+
+	// CacheStatusError indicates that the library item files could not be cached or evicted.
+	CacheStatusError = CacheStatus("Error")
+)
+
+const (
+	// CacheNotRequestedReason documents that the library item files are not cached because they were never
+	// requested, e.g. the items of an on-demand subscribed library.
+	CacheNotRequestedReason = "NotRequested"
+
+	// CacheEvictedReason documents that the library item files were evicted from disk in vCenter, e.g. by
+	// the cache eviction policy of the library.
+	CacheEvictedReason = "Evicted"
+
+	// CachingFailedReason documents that the transfer of the library item files to disk in vCenter failed.
+	CachingFailedReason = "CachingFailed"
+
+	// EvictionFailedReason documents that the removal of the library item files from disk in vCenter failed.
+	EvictionFailedReason = "EvictionFailed"
+)
+
+// SyncProgress describes the progress of the transfer of the library item content.
+type SyncProgress struct {
+	// Percentage is the completion percentage of the transfer.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percentage int32 `json:"percentage,omitempty"`
+
+	// BytesTransferred is the number of bytes that have been transferred so far.
+	// +optional
+	BytesTransferred int64 `json:"bytesTransferred,omitempty"`
+
+	// TotalBytes is the total number of bytes to transfer, if known.
+	// +optional
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+
+	// EstimatedCompletionTime is the estimated time at which the transfer completes.
+	// +optional
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty"`
+}
+
+// FileInfo describes a file of a library item.
+type FileInfo struct {
+	// Name is the name of the file in the library item.
+	// +required
+	Name string `json:"name"`
+
+	// Size is the size of the file in bytes.
+	// +optional
+	Size int64 `json:"size,omitempty"`
+
+	// Version is the version of the file. It is incremented when the content of the file is changed.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Cached indicates if the file is on disk in vCenter.
+	// +required
+	Cached bool `json:"cached"`
+
+	// Checksum is the checksum of the file, as computed by vCenter.
+	// +optional
+	Checksum *FileChecksum `json:"checksum,omitempty"`
+}
+
+// IsoInfo describes the metadata extracted from the contents of an ISO library item.
+type IsoInfo struct {
+	// VolumeLabel is the volume label of the ISO image.
+	// +optional
+	VolumeLabel string `json:"volumeLabel,omitempty"`
+
+	// Size is the size of the ISO image in bytes.
+	// +optional
+	Size int64 `json:"size,omitempty"`
+
+	// Bootable indicates whether the ISO image contains a boot catalog.
+	// +optional
+	Bootable bool `json:"bootable,omitempty"`
+
+	// OSHints are the guest OS identifiers detected from the contents of the ISO image, e.g. "ubuntu64Guest".
+	// They are best-effort and must not be relied on for security decisions.
+	// +listType=atomic
+	// +optional
+	OSHints []string `json:"osHints,omitempty"`
+}
+
+// DeploymentDefaults describes the default values for deploying a VM from an OVF library item,
+// as defined in the OVF descriptor.
+type DeploymentDefaults struct {
+	// Networks are the names of the networks the OVF connects to by default, from the NetworkSection.
+	// +listType=set
+	// +optional
+	Networks []string `json:"networks,omitempty"`
+
+	// DeploymentOption is the default deployment option, from the DeploymentOptionSection.
+	// +optional
+	DeploymentOption string `json:"deploymentOption,omitempty"`
+
+	// DeploymentOptions are all the deployment options available in the DeploymentOptionSection.
+	// +listType=set
+	// +optional
+	DeploymentOptions []string `json:"deploymentOptions,omitempty"`
+
+	// MinCPUs is the number of virtual CPUs required by the VirtualHardwareSection.
+	// +optional
+	MinCPUs int32 `json:"minCPUs,omitempty"`
+
+	// MinMemory is the amount of memory required by the VirtualHardwareSection.
+	// +optional
+	MinMemory *resource.Quantity `json:"minMemory,omitempty"`
+
+	// GuestOSID is the vSphere guest OS identifier of the OperatingSystemSection, e.g. "ubuntu64Guest".
+	// +optional
+	GuestOSID string `json:"guestOSID,omitempty"`
+
+	// Properties are the keys of the properties of the ProductSection that can be set at deployment time,
+	// e.g. "user-data" or "hostname".
+	// +listType=set
+	// +optional
+	Properties []string `json:"properties,omitempty"`
+}
+
+// EULAMaxInlineBytes is the maximum size of the text of a license agreement reported inline in the status of
+// a library item. Larger license agreements are stored in a ConfigMap.
+const EULAMaxInlineBytes = 4 * 1024
+
+// EULA describes a license agreement of the EulaSection of an OVF descriptor. Exactly one of Text and
+// ConfigMapKeyRef is set.
+type EULA struct {
+	// Text is the text of the license agreement, if it does not exceed EULAMaxInlineBytes.
+	// +optional
+	Text string `json:"text,omitempty"`
+
+	// ConfigMapKeyRef selects the key of a ConfigMap that holds the text of a license agreement exceeding
+	// EULAMaxInlineBytes. The ConfigMap is in the namespace of a ContentLibraryItem, or in the namespace of
+	// the content library operator for a ClusterContentLibraryItem.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// SBOMFormat is a constant type that indicates the format of a software bill of materials.
+type SBOMFormat string
+
+const (
+	// SBOMFormatSPDX indicates an SPDX document.
+	SBOMFormatSPDX = SBOMFormat("SPDX")
+
+	// SBOMFormatCycloneDX indicates a CycloneDX document.
+	SBOMFormatCycloneDX = SBOMFormat("CycloneDX")
+)
+
+// SBOMReference refers to the software bill of materials of the content of a library item. Exactly one of
+// ConfigMapKeyRef, SecretKeyRef and URL is set.
+// +kubebuilder:validation:XValidation:rule="[has(self.configMapKeyRef), has(self.secretKeyRef), has(self.url)].filter(x, x).size() == 1",message="exactly one of configMapKeyRef, secretKeyRef and url must be set"
+type SBOMReference struct {
+	// Format is the format of the software bill of materials.
+	// Possible values are "SPDX" and "CycloneDX".
+	// +kubebuilder:validation:Enum=SPDX;CycloneDX
+	// +required
+	Format SBOMFormat `json:"format"`
+
+	// ConfigMapKeyRef selects the key of a ConfigMap that holds the software bill of materials. The ConfigMap
+	// is in the namespace of a ContentLibraryItem, or in the namespace of the content library operator for a
+	// ClusterContentLibraryItem.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects the key of a Secret that holds the software bill of materials. The Secret is in the
+	// same namespace as the ConfigMap of ConfigMapKeyRef would be.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+
+	// URL is the HTTP(S) endpoint that serves the software bill of materials.
+	// +kubebuilder:validation:XValidation:rule="self.matches('^https?://')",message="must be an http or https URL"
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// PublisherInfo identifies the published library a subscribed library item was synchronized from.
+type PublisherInfo struct {
+	// LibraryName is the name of the published library in the publishing vCenter, if known.
+	// +optional
+	LibraryName string `json:"libraryName,omitempty"`
+
+	// LibraryUUID is the identifier of the published library in the publishing vCenter, if known.
+	// +optional
+	LibraryUUID LibraryID `json:"libraryUUID,omitempty"`
+
+	// VCenterInstanceUUID is the instance UUID of the publishing vCenter, if known.
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self.matches('^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$')",message="must be a UUID of the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+	// +optional
+	VCenterInstanceUUID string `json:"vCenterInstanceUUID,omitempty"`
+
+	// PublicationURLHost is the host, and port if any, of the subscription URL of the subscribed library.
+	// +optional
+	PublicationURLHost string `json:"publicationURLHost,omitempty"`
+}
+
+// SourceItemReference refers to the library item a library item was copied from.
+type SourceItemReference struct {
+	// Kind is the kind of the source library item.
+	// Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+	// +kubebuilder:validation:Enum=ContentLibraryItem;ClusterContentLibraryItem
+	// +required
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the source library item. It is empty for a ClusterContentLibraryItem.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the source library item resource.
+	// +required
+	Name string `json:"name"`
+
+	// UUID is the identifier of the source library item in vCenter, which remains valid after the source
+	// library item resource is deleted.
+	// +optional
+	UUID ItemID `json:"uuid,omitempty"`
+}
+
 // ContentLibraryReference contains the information to locate the content library resource.
 type ContentLibraryReference struct {
 	// Name is the name of resource being referenced.
@@ -31,10 +381,41 @@ type ContentLibraryReference struct {
 // ContentLibraryItemSpec defines the desired state of a ContentLibraryItem.
 type ContentLibraryItemSpec struct {
 	// UUID is the identifier which uniquely identifies the library item in vCenter. This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="uuid is immutable"
 	// +required
-	UUID string `json:"uuid"`
+	UUID ItemID `json:"uuid"`
+
+	// AttestationRef refers to the signatures or attestations of the content of the library item. When set,
+	// the content is verified against them and the result is reported by the SignatureVerified condition.
+	// +optional
+	AttestationRef *AttestationReference `json:"attestationRef,omitempty"`
+
+	// Name is the desired name of the library item in vCenter. When set, the library item is renamed in vCenter
+	// to match, and Status.Name reports the actual name once the rename is done.
+	// This field can only be set for items of a writable ContentLibrary.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Description is the desired human-readable description of the library item in vCenter. When set, the
+	// description is updated in vCenter to match, and Status.Description reports the actual description.
+	// This field can only be set for items of a writable ContentLibrary.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// CustomMetadata is the desired custom metadata of the library item in vCenter, e.g. build IDs, git
+	// commits or compliance tags. When set, the metadata of the item in vCenter is updated to match.
+	// The keys and values must not exceed CustomMetadataMaxBytes in total.
+	// This field can only be set for items of a writable ContentLibrary.
+	// +kubebuilder:validation:MaxProperties=64
+	// +mapType=granular
+	// +optional
+	CustomMetadata map[string]string `json:"customMetadata,omitempty"`
 }
 
+// CustomMetadataMaxBytes is the maximum total size of the keys and values of the custom metadata of a
+// library item.
+const CustomMetadataMaxBytes = 16 * 1024
+
 // ContentLibraryItemStatus defines the observed state of ContentLibraryItem.
 type ContentLibraryItemStatus struct {
 	// Name specifies the name of the content library item in vCenter specified by the user.
@@ -45,6 +426,52 @@ type ContentLibraryItemStatus struct {
 	// +required
 	ContentLibraryRef ContentLibraryReference `json:"contentLibraryRef"`
 
+	// SourceLibraryType indicates the type of the ContentLibrary this item belongs to.
+	// Possible types are "Local" and "Subscribed".
+	// +optional
+	SourceLibraryType ContentLibraryType `json:"sourceLibraryType,omitempty"`
+
+	// PublisherInfo identifies the published library the item was synchronized from.
+	// This field is populated only if SourceLibraryType is "Subscribed".
+	// +optional
+	PublisherInfo *PublisherInfo `json:"publisherInfo,omitempty"`
+
+	// CustomMetadata is the custom metadata of the library item in vCenter.
+	// +mapType=granular
+	// +optional
+	CustomMetadata map[string]string `json:"customMetadata,omitempty"`
+
+	// SourceItemRef refers to the library item this library item was copied from, if it was copied from
+	// another library.
+	// +optional
+	SourceItemRef *SourceItemReference `json:"sourceItemRef,omitempty"`
+
+	// CloneGeneration is the number of copies between the original library item and this library item, e.g.
+	// 2 for the namespace copy of a regional copy of a golden image. It is 0 for library items that were not
+	// copied.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	CloneGeneration int32 `json:"cloneGeneration,omitempty"`
+
+	// ImageRefs refers to the VirtualMachineImage resources generated from the library item, so that the image
+	// of a library item can be found without searching by UUID.
+	// +listType=map
+	// +listMapKey=kind
+	// +listMapKey=name
+	// +optional
+	ImageRefs []NameAndKindRef `json:"imageRefs,omitempty"`
+
+	// OCIRef is the OCI-style reference of the current content of the library item, of the form
+	// "registry/<library-uuid>/<item-uuid>:v<content-version>@<digest>", so that OCI signing, SBOM and policy
+	// tools can refer to it. This field is populated only if the operator is configured with a registry.
+	// +optional
+	OCIRef string `json:"ociRef,omitempty"`
+
+	// SBOMRef refers to the software bill of materials of the current content of the library item, attached
+	// by the pipeline that built the image.
+	// +optional
+	SBOMRef *SBOMReference `json:"sbomRef,omitempty"`
+
 	// Description is a human-readable description for this library item.
 	// +optional
 	Description string `json:"description,omitempty"`
@@ -60,22 +487,95 @@ type ContentLibraryItemStatus struct {
 	ContentVersion string `json:"contentVersion"`
 
 	// Type string indicates the type of the library item in vCenter.
-	// Possible types are "Ovf" and "Iso".
+	// Possible types are "Ovf", "Iso", "File" and "Unknown". Items of a type that is not supported by this API
+	// have the "Unknown" type, and their TypeSupported condition is false.
 	// +required
 	Type ContentLibraryItemType `json:"type"`
 
+	// RawType is the type of the library item as reported by vCenter, e.g. "ovf" or "iso". It is recorded for
+	// every item, and in particular explains the items of the "Unknown" type.
+	// +optional
+	RawType string `json:"rawType,omitempty"`
+
 	// Size indicates the library item size in bytes
 	// +optional
 	Size int32 `json:"size,omitempty"`
 
+	// Files describes the files of the library item.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Files []FileInfo `json:"files,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// DeploymentDefaults describes the default values for deploying a VM from the OVF.
+	// This field is populated only if the library item is of the "Ovf" type.
+	// +optional
+	DeploymentDefaults *DeploymentDefaults `json:"deploymentDefaults,omitempty"`
+
+	// HardwareVersion is the virtual hardware version of the VMs described by the OVF, e.g. 19 for "vmx-19".
+	// This field is populated only if the library item is of the "Ovf" type.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HardwareVersion int32 `json:"hardwareVersion,omitempty"`
+
+	// MinSupportedHWVersion is the highest virtual hardware version supported by all the target clusters,
+	// i.e. the lowest of the highest hardware versions the clusters support. The HardwareVersionSupported
+	// condition is false if HardwareVersion exceeds it.
+	// This field is populated only if the library item is of the "Ovf" type.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinSupportedHWVersion int32 `json:"minSupportedHWVersion,omitempty"`
+
+	// EULAs are the license agreements of the EulaSection of the OVF descriptor, which must be presented to
+	// and accepted by users deploying VMs from the library item.
+	// This field is populated only if the library item is of the "Ovf" type.
+	// +listType=atomic
+	// +optional
+	EULAs []EULA `json:"eulas,omitempty"`
+
+	// IsoInfo describes the metadata extracted from the ISO image.
+	// This field is populated only if the library item is of the "Iso" type.
+	// +optional
+	IsoInfo *IsoInfo `json:"isoInfo,omitempty"`
+
+	// StorageURIs lists the datastore URIs of the library item files, e.g.
+	// "ds:///vmfs/volumes/<datastore-uuid>/contentlib-<library-uuid>/<item-uuid>/disk-0.vmdk".
+	// This field is populated only when the library item files are cached.
+	// +listType=set
+	// +optional
+	StorageURIs []string `json:"storageURIs,omitempty"`
+
 	// Cached indicates if the library item files are on disk in vCenter.
+	//
+	// Deprecated: Use CacheStatus, which also reports why the library item files are not on disk.
 	// +required
 	Cached bool `json:"cached"`
 
+	// CacheStatus indicates whether the library item files are on disk in vCenter. It supersedes Cached,
+	// which is true only if CacheStatus is "Cached".
+	// Possible values are "Cached", "NotCached", "Caching", "Evicting" and "Error".
+	// +kubebuilder:validation:Enum=Cached;NotCached;Caching;Evicting;Error
+	// +optional
+	CacheStatus CacheStatus `json:"cacheStatus,omitempty"`
+
+	// CacheReason is a brief CamelCase reason for the CacheStatus, e.g. "NotRequested" or "CachingFailed".
+	// +optional
+	CacheReason string `json:"cacheReason,omitempty"`
+
+	// CacheMessage is a human readable message with details about the CacheStatus, e.g. the error that
+	// caused caching to fail.
+	// +optional
+	CacheMessage string `json:"cacheMessage,omitempty"`
+
 	// Ready denotes that the library item is ready to be used.
 	// +required
 	Ready bool `json:"ready"`
 
+	// Phase indicates the lifecycle phase of the library item.
+	// Possible values are "Available" and "Orphaned".
+	// +optional
+	Phase ContentLibraryItemPhase `json:"phase,omitempty"`
+
 	// CreationTime indicates the date and time when this library item was created.
 	// +required
 	CreationTime string `json:"creationTime"`
@@ -90,9 +590,63 @@ type ContentLibraryItemStatus struct {
 	// +optional
 	LastSyncTime string `json:"lastSyncTime,omitempty"`
 
+	// SyncProgress describes the progress of the ongoing transfer of the library item content.
+	// This field is populated only while the Transferring condition is true.
+	// +optional
+	SyncProgress *SyncProgress `json:"syncProgress,omitempty"`
+
+	// Signatures describes the signatures found at Spec.AttestationRef.
+	// +listType=atomic
+	// +optional
+	Signatures []SignatureInfo `json:"signatures,omitempty"`
+
+	// LastTransferThroughput is the average rate, in bytes per second, of the last completed transfer of the library item content.
+	// +optional
+	LastTransferThroughput int64 `json:"lastTransferThroughput,omitempty"`
+
+	// LastUsedTime indicates the time when the content of the library item was last used, e.g. to deploy
+	// a VM. It drives the eviction of the cached content of the item.
+	// +optional
+	LastUsedTime *metav1.Time `json:"lastUsedTime,omitempty"`
+
+	// DeployCount is the number of VMs deployed from the library item. It is incremented by the consumers of
+	// the library item, e.g. vm-operator, with RecordDeploy, so that unused images can be identified.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DeployCount int64 `json:"deployCount,omitempty"`
+
+	// LastDeployTime indicates the time when a VM was last deployed from the library item. It is set by the
+	// consumers of the library item together with DeployCount.
+	// +optional
+	LastDeployTime *metav1.Time `json:"lastDeployTime,omitempty"`
+
+	// LastObservedTime indicates the time when the controller last reconciled the library item. It is updated on
+	// every reconciliation, even when nothing changed, so that a wedged controller can be detected.
+	// +optional
+	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
+
+	// LastDriftCheckTime indicates the time when the library item was last compared with vCenter to detect
+	// out-of-band changes.
+	// +optional
+	LastDriftCheckTime *metav1.Time `json:"lastDriftCheckTime,omitempty"`
+
+	// LastTaskInfo describes the last vCenter task that failed for this library item.
+	// +optional
+	LastTaskInfo *TaskInfo `json:"lastTaskInfo,omitempty"`
+
+	// LastError describes the last error encountered while reconciling the library item, and whether it is retried.
+	// This field is cleared once the library item is reconciled successfully.
+	// +optional
+	LastError *ErrorInfo `json:"lastError,omitempty"`
+
+	// Summary is a one-line human readable summary of the state of the ContentLibraryItem, e.g. "Ready" or
+	// "Error: publisher certificate expired", derived from its conditions by conditions.ReadyMessage.
+	// +optional
+	Summary string `json:"summary,omitempty"`
+
 	// Conditions describes the current condition information of the ContentLibraryItem.
 	// +optional
-	Conditions Conditions `json:"conditions,omitempty"`
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 func (contentLibraryItem *ContentLibraryItem) GetConditions() Conditions {
@@ -103,12 +657,17 @@ func (contentLibraryItem *ContentLibraryItem) SetConditions(conditions Condition
 	contentLibraryItem.Status.Conditions = conditions
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Namespaced,shortName=clitem
+// +kubebuilder:resource:scope=Namespaced,shortName=clitem;libitem,categories=imageregistry;vmware;all
 // +kubebuilder:printcolumn:name="ContentLibraryRef",type="string",JSONPath=".status.contentLibraryRef.name"
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".status.type"
+// +kubebuilder:printcolumn:name="SourceLibraryType",type="string",priority=1,JSONPath=".status.sourceLibraryType"
 // +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+// +kubebuilder:printcolumn:name="Phase",type="string",priority=1,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Summary",type="string",priority=1,JSONPath=".status.summary"
+// +kubebuilder:printcolumn:name="Deploys",type="integer",priority=1,JSONPath=".status.deployCount"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="LastSyncTime",type="string",JSONPath=".status.lastSyncTime"
 
@@ -0,0 +1,276 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ContentLibraryItemFileUploadConditionUploaded indicates that all the files of a ContentLibraryItemFileUpload
+	// were transferred into the library item and validated.
+	ContentLibraryItemFileUploadConditionUploaded = ConditionType("Uploaded")
+
+	// UploadSessionExpiredReason documents that the vCenter update session of the upload expired before all the
+	// files were transferred.
+	UploadSessionExpiredReason = "UploadSessionExpired"
+
+	// ChecksumMismatchReason documents that the checksum of an uploaded file does not match the expected checksum.
+	ChecksumMismatchReason = "ChecksumMismatch"
+
+	// FileTransferFailedReason documents that the transfer of a file into the library item failed.
+	FileTransferFailedReason = "FileTransferFailed"
+)
+
+// FileTransferStatus is a constant type that indicates the transfer state of a file in vCenter.
+type FileTransferStatus string
+
+const (
+	// FileTransferStatusWaiting indicates that the file is waiting for its content to be transferred.
+	FileTransferStatusWaiting = FileTransferStatus("Waiting")
+
+	// FileTransferStatusTransferring indicates that the content of the file is being transferred.
+	FileTransferStatusTransferring = FileTransferStatus("Transferring")
+
+	// FileTransferStatusValidating indicates that the content of the file is being validated.
+	FileTransferStatusValidating = FileTransferStatus("Validating")
+
+	// FileTransferStatusReady indicates that the file has been fully transferred and is ready to be used.
+	FileTransferStatusReady = FileTransferStatus("Ready")
+
+	// FileTransferStatusError indicates that there was an error transferring or validating the file.
+	FileTransferStatusError = FileTransferStatus("Error")
+)
+
+// ChecksumAlgorithm is a constant type that indicates the algorithm used to calculate the checksum of a file.
+type ChecksumAlgorithm string
+
+const (
+	// ChecksumAlgorithmSHA1 indicates a SHA-1 checksum.
+	ChecksumAlgorithmSHA1 = ChecksumAlgorithm("SHA1")
+
+	// ChecksumAlgorithmSHA256 indicates a SHA-256 checksum.
+	ChecksumAlgorithmSHA256 = ChecksumAlgorithm("SHA256")
+
+	// ChecksumAlgorithmSHA512 indicates a SHA-512 checksum.
+	ChecksumAlgorithmSHA512 = ChecksumAlgorithm("SHA512")
+
+	// ChecksumAlgorithmMD5 indicates an MD5 checksum. MD5 only detects accidental corruption, and should
+	// only be used for sources that do not publish a stronger checksum.
+	ChecksumAlgorithmMD5 = ChecksumAlgorithm("MD5")
+)
+
+// SupportedChecksumAlgorithms lists the checksum algorithms supported by file checksums, strongest first.
+var SupportedChecksumAlgorithms = []ChecksumAlgorithm{
+	ChecksumAlgorithmSHA512,
+	ChecksumAlgorithmSHA256,
+	ChecksumAlgorithmSHA1,
+	ChecksumAlgorithmMD5,
+}
+
+// FileChecksum describes the checksum of a file.
+type FileChecksum struct {
+	// Algorithm is the algorithm used to calculate the checksum.
+	// Possible values are "SHA1", "SHA256", "SHA512" and "MD5".
+	// +kubebuilder:validation:Enum=SHA1;SHA256;SHA512;MD5
+	// +required
+	Algorithm ChecksumAlgorithm `json:"algorithm"`
+
+	// Value is the hex encoded checksum of the file.
+	// +required
+	Value string `json:"value"`
+}
+
+// HTTPFileSource describes a file that is pulled by vCenter from an HTTP(S) endpoint.
+type HTTPFileSource struct {
+	// URL is the HTTP(S) endpoint from which the file content is pulled.
+	// +required
+	URL string `json:"url"`
+
+	// SSLCertificate is the PEM encoded certificate of the HTTPS endpoint, used when the endpoint
+	// presents a certificate that is not trusted by vCenter.
+	// +optional
+	SSLCertificate string `json:"sslCertificate,omitempty"`
+}
+
+// PersistentVolumeClaimFileSource describes a file that is read from a PersistentVolumeClaim.
+type PersistentVolumeClaimFileSource struct {
+	// ClaimName is the name of the PersistentVolumeClaim in the same namespace as the upload.
+	// +required
+	ClaimName string `json:"claimName"`
+
+	// Path is the path of the file relative to the root of the volume.
+	// +required
+	Path string `json:"path"`
+}
+
+// FileUploadSource describes where the content of an uploaded file comes from.
+// Exactly one of the sources must be specified.
+// +kubebuilder:validation:XValidation:rule="[has(self.http), has(self.persistentVolumeClaim), has(self.inline)].filter(x, x).size() == 1",message="exactly one of http, persistentVolumeClaim and inline must be set"
+type FileUploadSource struct {
+	// HTTP specifies that the file content is pulled from an HTTP(S) endpoint.
+	// +optional
+	HTTP *HTTPFileSource `json:"http,omitempty"`
+
+	// PersistentVolumeClaim specifies that the file content is read from a PersistentVolumeClaim.
+	// +optional
+	PersistentVolumeClaim *PersistentVolumeClaimFileSource `json:"persistentVolumeClaim,omitempty"`
+
+	// Inline specifies the file content directly. Inline content is intended for small files, such as
+	// manifests or certificates, and is limited to 256KiB.
+	// +kubebuilder:validation:MaxLength=349528
+	// +optional
+	Inline []byte `json:"inline,omitempty"`
+}
+
+// FileUpload describes a single file that is uploaded into a library item.
+type FileUpload struct {
+	// Name is the name of the file in the library item.
+	// +required
+	Name string `json:"name"`
+
+	// Source describes where the content of the file comes from.
+	// +required
+	Source FileUploadSource `json:"source"`
+
+	// Checksum is the expected checksum of the file. If specified, the file is validated against it after the transfer.
+	// +optional
+	Checksum *FileChecksum `json:"checksum,omitempty"`
+}
+
+// FileUploadStatus describes the observed state of the transfer of a single file into a library item, either
+// uploaded by a ContentLibraryItemFileUpload or imported by a ContentLibraryItemImportRequest.
+type FileUploadStatus struct {
+	// Name is the name of the file in the library item.
+	// +required
+	Name string `json:"name"`
+
+	// Status indicates the transfer state of the file.
+	// Possible values are "Waiting", "Transferring", "Validating", "Ready" and "Error".
+	// +required
+	Status FileTransferStatus `json:"status"`
+
+	// BytesTransferred is the number of bytes that have been transferred so far.
+	// +optional
+	BytesTransferred int64 `json:"bytesTransferred,omitempty"`
+
+	// Size is the total size of the file in bytes, if known.
+	// +optional
+	Size int64 `json:"size,omitempty"`
+
+	// ChecksumVerified indicates whether the file was validated against the expected checksum.
+	// +optional
+	ChecksumVerified bool `json:"checksumVerified,omitempty"`
+
+	// ErrorMessage describes why the transfer of the file failed.
+	// +optional
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// ContentLibraryItemFileUploadSpec defines the desired state of a ContentLibraryItemFileUpload.
+type ContentLibraryItemFileUploadSpec struct {
+	// ContentLibraryItemRef is the name of the ContentLibraryItem in the same namespace that the files are
+	// uploaded into. The item must belong to a writable ContentLibrary. This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="contentLibraryItemRef is immutable"
+	// +required
+	ContentLibraryItemRef string `json:"contentLibraryItemRef"`
+
+	// Files is the list of files to upload into the library item. At most 100 files can be uploaded at once.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=map
+	// +listMapKey=name
+	// +required
+	Files []FileUpload `json:"files" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// TransferRateLimit is the maximum rate, in bytes per second, at which the files are transferred, e.g.
+	// "100Mi". If unset, the transfer is not limited.
+	// +optional
+	TransferRateLimit *resource.Quantity `json:"transferRateLimit,omitempty"`
+
+	// TTLSecondsAfterFinished is the number of seconds after which the upload is deleted once it completed,
+	// so that completed uploads do not accumulate. 0 deletes the upload as soon as it completed.
+	// Defaults to DefaultTTLSecondsAfterFinished.
+	// +kubebuilder:default=86400
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// ContentLibraryItemFileUploadStatus defines the observed state of a ContentLibraryItemFileUpload.
+type ContentLibraryItemFileUploadStatus struct {
+	// SessionUUID is the identifier of the vCenter update session used to upload the files.
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self.matches('^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$')",message="must be a UUID of the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+	// +optional
+	SessionUUID string `json:"sessionUUID,omitempty"`
+
+	// SessionExpirationTime indicates the time after which the update session expires in vCenter if no
+	// further progress is made.
+	// +optional
+	SessionExpirationTime *metav1.Time `json:"sessionExpirationTime,omitempty"`
+
+	// Files describes the observed state of each uploaded file.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Files []FileUploadStatus `json:"files,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// LastTransferThroughput is the average rate, in bytes per second, of the transfer of the files.
+	// +optional
+	LastTransferThroughput int64 `json:"lastTransferThroughput,omitempty"`
+
+	// CompletionTime indicates the time when all the files were uploaded and validated, or when the upload
+	// failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// ExpiryTime indicates the time after which the upload is deleted, i.e. CompletionTime plus
+	// Spec.TTLSecondsAfterFinished. It is set once the upload completed.
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+
+	// Conditions describes the current condition information of the ContentLibraryItemFileUpload.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (fileUpload *ContentLibraryItemFileUpload) GetConditions() Conditions {
+	return fileUpload.Status.Conditions
+}
+
+func (fileUpload *ContentLibraryItemFileUpload) SetConditions(conditions Conditions) {
+	fileUpload.Status.Conditions = conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=clitemupload,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="ContentLibraryItemRef",type="string",JSONPath=".spec.contentLibraryItemRef"
+// +kubebuilder:printcolumn:name="Uploaded",type="string",JSONPath=".status.conditions[?(@.type=='Uploaded')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ContentLibraryItemFileUpload is the schema for the content library item file upload API.
+// It uploads one or more files into an existing item of a writable content library.
+type ContentLibraryItemFileUpload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContentLibraryItemFileUploadSpec   `json:"spec,omitempty"`
+	Status ContentLibraryItemFileUploadStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContentLibraryItemFileUploadList contains a list of ContentLibraryItemFileUpload.
+type ContentLibraryItemFileUploadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContentLibraryItemFileUpload `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ContentLibraryItemFileUpload{}, &ContentLibraryItemFileUploadList{})
+}
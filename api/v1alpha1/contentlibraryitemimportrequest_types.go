@@ -0,0 +1,178 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImportPhase is a constant type that indicates the phase of an import.
+type ImportPhase string
+
+const (
+	// ImportPhasePending indicates that the import has not started yet.
+	ImportPhasePending = ImportPhase("Pending")
+
+	// ImportPhaseRunning indicates that the import is in progress.
+	ImportPhaseRunning = ImportPhase("Running")
+
+	// ImportPhaseSucceeded indicates that the import completed successfully.
+	ImportPhaseSucceeded = ImportPhase("Succeeded")
+
+	// ImportPhaseFailed indicates that the import failed.
+	ImportPhaseFailed = ImportPhase("Failed")
+)
+
+// ContentLibraryItemImportRequestSource describes the source of an imported image.
+type ContentLibraryItemImportRequestSource struct {
+	// URL is the HTTP(S) endpoint of the OVF descriptor, OVA or ISO image to import.
+	// +required
+	URL string `json:"url"`
+
+	// SSLCertificate is the PEM encoded certificate of the HTTPS endpoint, used when the endpoint
+	// presents a certificate that is not trusted by vCenter.
+	// +optional
+	SSLCertificate string `json:"sslCertificate,omitempty"`
+
+	// Checksum is the expected checksum of the file at URL. If specified, the file is validated against it
+	// after the transfer.
+	// +optional
+	Checksum *FileChecksum `json:"checksum,omitempty"`
+}
+
+// ContentLibraryItemImportRequestTarget describes the library item an image is imported into.
+// Exactly one of ContentLibraryRef and ClusterContentLibraryRef must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.contentLibraryRef) != has(self.clusterContentLibraryRef)",message="exactly one of contentLibraryRef and clusterContentLibraryRef must be set"
+type ContentLibraryItemImportRequestTarget struct {
+	// ContentLibraryRef is the name of the writable ContentLibrary in the same namespace that the image is
+	// imported into.
+	// +optional
+	ContentLibraryRef string `json:"contentLibraryRef,omitempty"`
+
+	// ClusterContentLibraryRef is the name of the writable ClusterContentLibrary that the image is imported
+	// into. The namespace of the ContentLibraryItemImportRequest must be selected by the allowedNamespaces of
+	// the ClusterContentLibrary.
+	// +optional
+	ClusterContentLibraryRef string `json:"clusterContentLibraryRef,omitempty"`
+
+	// ItemName is the name of the library item created in vCenter.
+	// +required
+	ItemName string `json:"itemName"`
+
+	// ItemDescription is the description of the library item created in vCenter.
+	// +optional
+	ItemDescription string `json:"itemDescription,omitempty"`
+
+	// ItemType is the type of the library item created in vCenter.
+	// Possible types are "Ovf", "Iso" and "File".
+	// +kubebuilder:validation:Enum=Ovf;Iso;File
+	// +required
+	ItemType ContentLibraryItemType `json:"itemType"`
+}
+
+// ContentLibraryItemImportRequestSpec defines the desired state of a ContentLibraryItemImportRequest.
+type ContentLibraryItemImportRequestSpec struct {
+	// Source describes the source of the image to import.
+	// +required
+	Source ContentLibraryItemImportRequestSource `json:"source"`
+
+	// Target describes the library item the image is imported into.
+	// +required
+	Target ContentLibraryItemImportRequestTarget `json:"target"`
+
+	// TransferRateLimit is the maximum rate, in bytes per second, at which the image is transferred, e.g.
+	// "100Mi". If unset, the transfer is not limited.
+	// +optional
+	TransferRateLimit *resource.Quantity `json:"transferRateLimit,omitempty"`
+
+	// TTLSecondsAfterFinished is the number of seconds after which the request is deleted once the import
+	// completed, so that completed requests do not accumulate. 0 deletes the request as soon as it completed.
+	// Defaults to DefaultTTLSecondsAfterFinished.
+	// +kubebuilder:default=86400
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// ContentLibraryItemImportRequestStatus defines the observed state of a ContentLibraryItemImportRequest.
+type ContentLibraryItemImportRequestStatus struct {
+	// Phase indicates the phase of the import.
+	// Possible values are "Pending", "Running", "Succeeded" and "Failed".
+	// +optional
+	Phase ImportPhase `json:"phase,omitempty"`
+
+	// ContentLibraryItemRef is the name of the ContentLibraryItem, or of the ClusterContentLibraryItem if the
+	// image is imported into a ClusterContentLibrary, created for the imported image.
+	// +optional
+	ContentLibraryItemRef string `json:"contentLibraryItemRef,omitempty"`
+
+	// Files describes the progress of the transfer of each file of the imported image, e.g. the descriptor
+	// and every disk of an OVF template.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Files []FileUploadStatus `json:"files,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// LastTransferThroughput is the average rate, in bytes per second, of the last completed transfer of the image.
+	// +optional
+	LastTransferThroughput int64 `json:"lastTransferThroughput,omitempty"`
+
+	// StartTime indicates the time when the import was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime indicates the time when the import succeeded or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// ExpiryTime indicates the time after which the request is deleted, i.e. CompletionTime plus
+	// Spec.TTLSecondsAfterFinished. It is set once the import completed.
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+
+	// Conditions describes the current condition information of the ContentLibraryItemImportRequest.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (importRequest *ContentLibraryItemImportRequest) GetConditions() Conditions {
+	return importRequest.Status.Conditions
+}
+
+func (importRequest *ContentLibraryItemImportRequest) SetConditions(conditions Conditions) {
+	importRequest.Status.Conditions = conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=climportreq,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="ContentLibraryRef",type="string",JSONPath=".spec.target.contentLibraryRef"
+// +kubebuilder:printcolumn:name="ClusterContentLibraryRef",type="string",priority=1,JSONPath=".spec.target.clusterContentLibraryRef"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="ContentLibraryItemRef",type="string",JSONPath=".status.contentLibraryItemRef"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ContentLibraryItemImportRequest is the schema for the content library item import request API.
+// It imports an image from an HTTP(S) endpoint into a new item of a writable content library.
+type ContentLibraryItemImportRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContentLibraryItemImportRequestSpec   `json:"spec,omitempty"`
+	Status ContentLibraryItemImportRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContentLibraryItemImportRequestList contains a list of ContentLibraryItemImportRequest.
+type ContentLibraryItemImportRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContentLibraryItemImportRequest `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ContentLibraryItemImportRequest{}, &ContentLibraryItemImportRequestList{})
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ItemDigest is a compact description of a library item, used by consumers that do not need the full
+// library item resource.
+type ItemDigest struct {
+	// Name is the name of the library item resource.
+	// +required
+	Name string `json:"name"`
+
+	// UUID is the identifier of the library item in vCenter.
+	// +required
+	UUID ItemID `json:"uuid"`
+
+	// Ready denotes that the library item is ready to be used.
+	// +required
+	Ready bool `json:"ready"`
+
+	// ContentVersion indicates the version of the library item content.
+	// +optional
+	ContentVersion string `json:"contentVersion,omitempty"`
+}
+
+// ItemSummaryStatus defines the observed state of the library items of a library.
+type ItemSummaryStatus struct {
+	// TotalItems is the number of library items in the library.
+	// +optional
+	TotalItems int32 `json:"totalItems,omitempty"`
+
+	// ReadyItems is the number of library items in the library that are ready to be used.
+	// +optional
+	ReadyItems int32 `json:"readyItems,omitempty"`
+
+	// Items is the digest of every library item in the library, sorted by name. At most 10000 library items
+	// are listed, which keeps the resource within the size limit of the API server.
+	// +kubebuilder:validation:MaxItems=10000
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Items []ItemDigest `json:"items,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// LastUpdateTime indicates the time when the summary was last updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// Conditions describes the current condition information of the summary. Ready is True once the summary
+	// reflects every library item of the library.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=clitemsummary,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="TotalItems",type="integer",JSONPath=".status.totalItems"
+// +kubebuilder:printcolumn:name="ReadyItems",type="integer",JSONPath=".status.readyItems"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ContentLibraryItemSummary is the schema for the content library item summary API.
+// The content library operator maintains one ContentLibraryItemSummary per ContentLibrary, with the same name
+// and namespace as the library, so lightweight consumers can watch a single object instead of every
+// ContentLibraryItem of a large library.
+type ContentLibraryItemSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status ItemSummaryStatus `json:"status,omitempty"`
+}
+
+func (summary *ContentLibraryItemSummary) GetConditions() Conditions {
+	return summary.Status.Conditions
+}
+
+func (summary *ContentLibraryItemSummary) SetConditions(conditions Conditions) {
+	summary.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ContentLibraryItemSummaryList contains a list of ContentLibraryItemSummary.
+type ContentLibraryItemSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContentLibraryItemSummary `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ContentLibraryItemSummary{}, &ContentLibraryItemSummaryList{})
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ContentLibraryItemValidationRequestConditionCompleted indicates whether the validation of the library
+	// item completed, regardless of its result.
+	ContentLibraryItemValidationRequestConditionCompleted = ConditionType("Completed")
+
+	// ContentLibraryItemValidationRequestConditionValid indicates whether the library item passed the
+	// validation without errors. Warnings do not make a library item invalid.
+	ContentLibraryItemValidationRequestConditionValid = ConditionType("Valid")
+
+	// ValidationErrorsFoundReason documents that the validation found one or more errors.
+	ValidationErrorsFoundReason = "ValidationErrorsFound"
+
+	// UnsupportedItemTypeReason documents that the library item is of a type that cannot be validated.
+	UnsupportedItemTypeReason = "UnsupportedItemType"
+)
+
+// ValidationSeverity is a constant type that indicates the severity of a validation finding.
+type ValidationSeverity string
+
+const (
+	// ValidationSeverityError indicates a finding that prevents VMs from being deployed from the library item.
+	ValidationSeverityError = ValidationSeverity("Error")
+
+	// ValidationSeverityWarning indicates a finding that may cause VMs deployed from the library item to
+	// behave unexpectedly.
+	ValidationSeverityWarning = ValidationSeverity("Warning")
+)
+
+// ValidationFinding describes an issue found while validating a library item.
+type ValidationFinding struct {
+	// Severity is the severity of the finding.
+	// Possible values are "Error" and "Warning".
+	// +required
+	Severity ValidationSeverity `json:"severity"`
+
+	// Code is a CamelCase identifier of the check that produced the finding, e.g. "InvalidDescriptor".
+	// +required
+	Code string `json:"code"`
+
+	// Message is a human-readable description of the finding.
+	// +required
+	Message string `json:"message"`
+
+	// Location identifies where the finding was found, e.g. the OVF section or the file name.
+	// +optional
+	Location string `json:"location,omitempty"`
+}
+
+// ContentLibraryItemValidationRequestSpec defines the desired state of a ContentLibraryItemValidationRequest.
+type ContentLibraryItemValidationRequestSpec struct {
+	// ItemRef refers to the library item to validate. This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="itemRef is immutable"
+	// +required
+	ItemRef LibraryItemReference `json:"itemRef"`
+
+	// TTLSecondsAfterFinished is the number of seconds after which the request is deleted once the validation
+	// completed, so that completed requests do not accumulate. 0 deletes the request as soon as it completed.
+	// Defaults to DefaultTTLSecondsAfterFinished.
+	// +kubebuilder:default=86400
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// ContentLibraryItemValidationRequestStatus defines the observed state of a ContentLibraryItemValidationRequest.
+type ContentLibraryItemValidationRequestStatus struct {
+	// ContentVersion is the content version of the library item that was validated.
+	// +optional
+	ContentVersion string `json:"contentVersion,omitempty"`
+
+	// Errors is the number of findings with the "Error" severity.
+	// +optional
+	Errors int32 `json:"errors,omitempty"`
+
+	// Warnings is the number of findings with the "Warning" severity.
+	// +optional
+	Warnings int32 `json:"warnings,omitempty"`
+
+	// Findings are the issues found in the library item, errors first.
+	// +listType=atomic
+	// +optional
+	Findings []ValidationFinding `json:"findings,omitempty"`
+
+	// UnsupportedSections are the OVF sections of the descriptor that are not supported when deploying VMs,
+	// e.g. "DeploymentOptionSection".
+	// +listType=set
+	// +optional
+	UnsupportedSections []string `json:"unsupportedSections,omitempty"`
+
+	// CompletionTime indicates the time when the validation completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// ExpiryTime indicates the time after which the request is deleted, i.e. CompletionTime plus
+	// Spec.TTLSecondsAfterFinished. It is set once the validation completed.
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+
+	// Conditions describes the current condition information of the ContentLibraryItemValidationRequest.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (validationRequest *ContentLibraryItemValidationRequest) GetConditions() Conditions {
+	return validationRequest.Status.Conditions
+}
+
+func (validationRequest *ContentLibraryItemValidationRequest) SetConditions(conditions Conditions) {
+	validationRequest.Status.Conditions = conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=clitemvalidation,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="ItemKind",type="string",JSONPath=".spec.itemRef.kind"
+// +kubebuilder:printcolumn:name="ItemName",type="string",JSONPath=".spec.itemRef.name"
+// +kubebuilder:printcolumn:name="Errors",type="integer",JSONPath=".status.errors"
+// +kubebuilder:printcolumn:name="Warnings",type="integer",JSONPath=".status.warnings"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ContentLibraryItemValidationRequest is the schema for the content library item validation request API.
+// It runs pre-flight checks, such as OVF schema validation and descriptor lint checks, against a library item
+// and reports the findings, so that bad templates are flagged before VMs are deployed from them.
+type ContentLibraryItemValidationRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContentLibraryItemValidationRequestSpec   `json:"spec,omitempty"`
+	Status ContentLibraryItemValidationRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContentLibraryItemValidationRequestList contains a list of ContentLibraryItemValidationRequest.
+type ContentLibraryItemValidationRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContentLibraryItemValidationRequest `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ContentLibraryItemValidationRequest{}, &ContentLibraryItemValidationRequestList{})
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ContentLibraryItemVersionSpec identifies the content version of a ContentLibraryItem. It is immutable.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type ContentLibraryItemVersionSpec struct {
+	// ContentLibraryItemRef is the name of the ContentLibraryItem in the same namespace this is a version of.
+	// +required
+	ContentLibraryItemRef string `json:"contentLibraryItemRef"`
+
+	// ContentVersion is the content version of the library item, as reported by the Status.ContentVersion of
+	// the ContentLibraryItem.
+	// +required
+	ContentVersion string `json:"contentVersion"`
+}
+
+// FileChangeType is a constant type that indicates how a file of a library item changed between two content
+// versions.
+type FileChangeType string
+
+const (
+	// FileChangeTypeAdded indicates a file that was added to the library item.
+	FileChangeTypeAdded = FileChangeType("Added")
+
+	// FileChangeTypeRemoved indicates a file that was removed from the library item.
+	FileChangeTypeRemoved = FileChangeType("Removed")
+
+	// FileChangeTypeChanged indicates a file whose content changed.
+	FileChangeTypeChanged = FileChangeType("Changed")
+)
+
+// FileChange describes how a file of a library item changed between two content versions.
+type FileChange struct {
+	// Name is the name of the file in the library item.
+	// +required
+	Name string `json:"name"`
+
+	// Type indicates how the file changed.
+	// Possible values are "Added", "Removed" and "Changed".
+	// +kubebuilder:validation:Enum=Added;Removed;Changed
+	// +required
+	Type FileChangeType `json:"type"`
+
+	// OldChecksum is the checksum of the file at the previous content version. It is unset for added files.
+	// +optional
+	OldChecksum *FileChecksum `json:"oldChecksum,omitempty"`
+
+	// NewChecksum is the checksum of the file at this content version. It is unset for removed files.
+	// +optional
+	NewChecksum *FileChecksum `json:"newChecksum,omitempty"`
+
+	// OldSize is the size of the file in bytes at the previous content version.
+	// +optional
+	OldSize int64 `json:"oldSize,omitempty"`
+
+	// NewSize is the size of the file in bytes at this content version.
+	// +optional
+	NewSize int64 `json:"newSize,omitempty"`
+}
+
+// ContentLibraryItemVersionStatus defines the observed state of a ContentLibraryItemVersion.
+type ContentLibraryItemVersionStatus struct {
+	// UUID is the identifier of the library item in vCenter.
+	// +optional
+	UUID ItemID `json:"uuid,omitempty"`
+
+	// Type is the type of the library item at this version.
+	// +optional
+	Type ContentLibraryItemType `json:"type,omitempty"`
+
+	// Files describes the files of the library item at this version, including their checksums.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Files []FileInfo `json:"files,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// CaptureTime indicates the time when the content of the library item was observed at this version.
+	// +optional
+	CaptureTime *metav1.Time `json:"captureTime,omitempty"`
+
+	// PreviousContentVersion is the content version of the library item that was recorded before this one, if
+	// any. Changes are relative to it.
+	// +optional
+	PreviousContentVersion string `json:"previousContentVersion,omitempty"`
+
+	// Changes describes the files that were added, removed or changed since PreviousContentVersion, as
+	// computed by DiffFiles, so that users can see what changed when the content of the library item was
+	// updated. Unchanged files are not listed.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Changes []FileChange `json:"changes,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// Current indicates whether this is the current content version of the library item. VMs pinned to a
+	// version that is no longer current cannot be deployed from the library item until it is reverted.
+	// +optional
+	Current bool `json:"current,omitempty"`
+
+	// Conditions describes the current condition information of the ContentLibraryItemVersion. Ready is True
+	// once the files and changes of the version are recorded.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (itemVersion *ContentLibraryItemVersion) GetConditions() Conditions {
+	return itemVersion.Status.Conditions
+}
+
+func (itemVersion *ContentLibraryItemVersion) SetConditions(conditions Conditions) {
+	itemVersion.Status.Conditions = conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=clitemversion,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="ContentLibraryItemRef",type="string",JSONPath=".spec.contentLibraryItemRef"
+// +kubebuilder:printcolumn:name="ContentVersion",type="string",JSONPath=".spec.contentVersion"
+// +kubebuilder:printcolumn:name="Current",type="boolean",JSONPath=".status.current"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ContentLibraryItemVersion is the schema for the content library item version API.
+// The content library operator creates a ContentLibraryItemVersion, named by ContentLibraryItemVersionName,
+// every time the content of a ContentLibraryItem changes, so that VM specs can pin an exact image revision.
+type ContentLibraryItemVersion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContentLibraryItemVersionSpec   `json:"spec,omitempty"`
+	Status ContentLibraryItemVersionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContentLibraryItemVersionList contains a list of ContentLibraryItemVersion.
+type ContentLibraryItemVersionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContentLibraryItemVersion `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ContentLibraryItemVersion{}, &ContentLibraryItemVersionList{})
+}
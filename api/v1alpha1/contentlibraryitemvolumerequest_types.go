@@ -0,0 +1,145 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ContentLibraryItemVolumeRequestConditionVolumeReady indicates whether the PersistentVolumeClaim was
+	// created and the content of the library item was written to its volume.
+	ContentLibraryItemVolumeRequestConditionVolumeReady = ConditionType("VolumeReady")
+
+	// FileNotFoundReason documents that the library item has no file with the requested name, or no file that
+	// can be materialized.
+	FileNotFoundReason = "FileNotFound"
+
+	// ClaimAlreadyExistsReason documents that a PersistentVolumeClaim with the requested name already exists
+	// and was not created for the request.
+	ClaimAlreadyExistsReason = "ClaimAlreadyExists"
+)
+
+// ContentLibraryItemVolumeRequestSpec defines the desired state of a ContentLibraryItemVolumeRequest.
+// It is immutable.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type ContentLibraryItemVolumeRequestSpec struct {
+	// ItemRef refers to the library item whose content is materialized.
+	// +required
+	ItemRef LibraryItemReference `json:"itemRef"`
+
+	// FileName is the name of the file of the library item that is materialized, e.g. the disk of an OVF
+	// library item. If unset, the ISO image of an "Iso" library item, or the first disk of an "Ovf" library
+	// item, is materialized.
+	// +optional
+	FileName string `json:"fileName,omitempty"`
+
+	// ClaimName is the name of the PersistentVolumeClaim created in the namespace of the request. If unset,
+	// the name of the request is used.
+	// +optional
+	ClaimName string `json:"claimName,omitempty"`
+
+	// StorageClassName is the name of the StorageClass of the PersistentVolumeClaim.
+	// +required
+	StorageClassName string `json:"storageClassName"`
+
+	// AccessModes are the access modes of the PersistentVolumeClaim. Defaults to ReadWriteOnce.
+	// +listType=atomic
+	// +optional
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+
+	// VolumeMode is the volume mode of the PersistentVolumeClaim. Defaults to Block.
+	// +optional
+	VolumeMode *corev1.PersistentVolumeMode `json:"volumeMode,omitempty"`
+
+	// Size is the requested size of the PersistentVolumeClaim. If unset, the size of the materialized file
+	// is used. It must not be smaller than the size of the file.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+
+	// TTLSecondsAfterFinished is the number of seconds after which the request is deleted once the
+	// materialization completed, so that completed requests do not accumulate. Deleting the request does not
+	// delete the PersistentVolumeClaim it created. 0 deletes the request as soon as it completed.
+	// Defaults to DefaultTTLSecondsAfterFinished.
+	// +kubebuilder:default=86400
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// ContentLibraryItemVolumeRequestStatus defines the observed state of a ContentLibraryItemVolumeRequest.
+type ContentLibraryItemVolumeRequestStatus struct {
+	// Phase indicates the phase of the materialization.
+	// Possible values are "Pending", "Running", "Succeeded" and "Failed".
+	// +optional
+	Phase ImportPhase `json:"phase,omitempty"`
+
+	// ClaimRef refers to the PersistentVolumeClaim created in the namespace of the request.
+	// +optional
+	ClaimRef *corev1.LocalObjectReference `json:"claimRef,omitempty"`
+
+	// ContentVersion is the content version of the library item that was materialized.
+	// +optional
+	ContentVersion string `json:"contentVersion,omitempty"`
+
+	// Progress describes the progress of the copy of the file to the volume.
+	// This field is populated only while the phase is "Running".
+	// +optional
+	Progress *SyncProgress `json:"progress,omitempty"`
+
+	// CompletionTime indicates the time when the materialization succeeded or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// ExpiryTime indicates the time after which the request is deleted, i.e. CompletionTime plus
+	// Spec.TTLSecondsAfterFinished. It is set once the materialization completed.
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+
+	// Conditions describes the current condition information of the ContentLibraryItemVolumeRequest.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (volumeRequest *ContentLibraryItemVolumeRequest) GetConditions() Conditions {
+	return volumeRequest.Status.Conditions
+}
+
+func (volumeRequest *ContentLibraryItemVolumeRequest) SetConditions(conditions Conditions) {
+	volumeRequest.Status.Conditions = conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=clitemvolreq,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="ItemName",type="string",JSONPath=".spec.itemRef.name"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Claim",type="string",JSONPath=".status.claimRef.name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ContentLibraryItemVolumeRequest is the schema for the content library item volume request API.
+// It materializes an ISO image or a disk of a library item into a new PersistentVolumeClaim, backed by a
+// CNS volume, so that workloads can boot from the image.
+type ContentLibraryItemVolumeRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContentLibraryItemVolumeRequestSpec   `json:"spec,omitempty"`
+	Status ContentLibraryItemVolumeRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContentLibraryItemVolumeRequestList contains a list of ContentLibraryItemVolumeRequest.
+type ContentLibraryItemVolumeRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContentLibraryItemVolumeRequest `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ContentLibraryItemVolumeRequest{}, &ContentLibraryItemVolumeRequestList{})
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ContentLibraryReplicationConditionReplicated indicates whether all the selected library items of the
+	// source library were replicated into the destination library by the last replication.
+	ContentLibraryReplicationConditionReplicated = ConditionType("Replicated")
+
+	// SourceLibraryNotFoundReason documents that the source library of a replication does not exist.
+	SourceLibraryNotFoundReason = "SourceLibraryNotFound"
+
+	// DestinationNotWritableReason documents that the destination library of a replication is not writable.
+	DestinationNotWritableReason = "DestinationNotWritable"
+
+	// ItemReplicationFailedReason documents that the replication of one or more library items failed.
+	ItemReplicationFailedReason = "ItemReplicationFailed"
+)
+
+// ReplicationSource refers to the library whose items are replicated.
+type ReplicationSource struct {
+	// Kind is the kind of the source library.
+	// Possible values are "ContentLibrary" and "ClusterContentLibrary".
+	// +kubebuilder:validation:Enum=ContentLibrary;ClusterContentLibrary
+	// +required
+	Kind string `json:"kind"`
+
+	// Name is the name of the source library. A ContentLibrary must be in the same namespace as the
+	// ContentLibraryReplication.
+	// +required
+	Name string `json:"name"`
+
+	// ItemSelector selects the library items of the source library that are replicated by their labels.
+	// If unset, all the library items are replicated.
+	// +optional
+	ItemSelector *metav1.LabelSelector `json:"itemSelector,omitempty"`
+}
+
+// ItemReplicationStatus describes the observed state of the replication of a library item.
+type ItemReplicationStatus struct {
+	// SourceItemRef is the name of the library item resource in the source library.
+	// +required
+	SourceItemRef string `json:"sourceItemRef"`
+
+	// DestinationItemRef is the name of the ContentLibraryItem created in the destination library.
+	// +optional
+	DestinationItemRef string `json:"destinationItemRef,omitempty"`
+
+	// ContentVersion is the content version of the source library item that was last replicated.
+	// +optional
+	ContentVersion string `json:"contentVersion,omitempty"`
+
+	// Phase indicates the phase of the replication of the library item.
+	// Possible values are "Pending", "Running", "Succeeded" and "Failed".
+	// +optional
+	Phase ImportPhase `json:"phase,omitempty"`
+
+	// Message describes why the replication of the library item failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ContentLibraryReplicationSpec defines the desired state of a ContentLibraryReplication.
+type ContentLibraryReplicationSpec struct {
+	// Source refers to the library whose items are replicated.
+	// +required
+	Source ReplicationSource `json:"source"`
+
+	// DestinationRef is the name of the writable ContentLibrary in the same namespace that the library items
+	// are replicated into. It may belong to a different vCenter than the source library.
+	// +required
+	DestinationRef string `json:"destinationRef"`
+
+	// Schedule is the schedule of the replication in the cron format, e.g. "0 2 * * *". If unset, the library
+	// items are replicated whenever their content changes in the source library.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Suspend suspends the replication. Replications that are in progress are completed.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// ContentLibraryReplicationStatus defines the observed state of a ContentLibraryReplication.
+type ContentLibraryReplicationStatus struct {
+	// LastReplicationTime indicates the time when the last replication started.
+	// +optional
+	LastReplicationTime *metav1.Time `json:"lastReplicationTime,omitempty"`
+
+	// LastCompletionTime indicates the time when the last replication completed.
+	// +optional
+	LastCompletionTime *metav1.Time `json:"lastCompletionTime,omitempty"`
+
+	// Items describes the observed state of the replication of each selected library item.
+	// +listType=map
+	// +listMapKey=sourceItemRef
+	// +optional
+	Items []ItemReplicationStatus `json:"items,omitempty" patchStrategy:"merge" patchMergeKey:"sourceItemRef"`
+
+	// Conditions describes the current condition information of the ContentLibraryReplication.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (replication *ContentLibraryReplication) GetConditions() Conditions {
+	return replication.Status.Conditions
+}
+
+func (replication *ContentLibraryReplication) SetConditions(conditions Conditions) {
+	replication.Status.Conditions = conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=clreplication,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".spec.source.name"
+// +kubebuilder:printcolumn:name="Destination",type="string",JSONPath=".spec.destinationRef"
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule"
+// +kubebuilder:printcolumn:name="LastReplicationTime",type="date",JSONPath=".status.lastReplicationTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ContentLibraryReplication is the schema for the content library replication API.
+// It replicates the library items of a source library into a writable destination library, possibly in
+// another vCenter, either on a schedule or whenever their content changes.
+type ContentLibraryReplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContentLibraryReplicationSpec   `json:"spec,omitempty"`
+	Status ContentLibraryReplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContentLibraryReplicationList contains a list of ContentLibraryReplication.
+type ContentLibraryReplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContentLibraryReplication `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ContentLibraryReplication{}, &ContentLibraryReplicationList{})
+}
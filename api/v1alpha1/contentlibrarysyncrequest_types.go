@@ -0,0 +1,103 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ContentLibrarySyncRequestConditionCompleted indicates whether the synchronization requested by a
+	// ContentLibrarySyncRequest completed successfully.
+	ContentLibrarySyncRequestConditionCompleted = ConditionType("Completed")
+
+	// LibraryNotSubscribedReason documents that the library a synchronization was requested for is not of the
+	// "Subscribed" type.
+	LibraryNotSubscribedReason = "LibraryNotSubscribed"
+)
+
+// ContentLibrarySyncRequestSpec identifies the subscribed library, and optionally the library items, to
+// synchronize. It is immutable. ClusterContentLibraries cannot be synchronized with a request, since they are
+// shared by every namespace and the users of a namespace must not be able to synchronize them.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type ContentLibrarySyncRequestSpec struct {
+	// ContentLibraryRef is the name of the subscribed ContentLibrary in the same namespace to synchronize.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ContentLibraryRef string `json:"contentLibraryRef"`
+
+	// ItemRefs are the names of the ContentLibraryItem resources of the library to synchronize. If empty, the
+	// whole library is synchronized.
+	// +listType=set
+	// +optional
+	ItemRefs []string `json:"itemRefs,omitempty"`
+
+	// TTLSecondsAfterFinished is the number of seconds after which the request is deleted once the synchronization
+	// completed, so that completed requests do not accumulate. 0 deletes the request as soon as it completed.
+	// Defaults to DefaultTTLSecondsAfterFinished.
+	// +kubebuilder:default=86400
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// ContentLibrarySyncRequestStatus defines the observed state of a ContentLibrarySyncRequest.
+type ContentLibrarySyncRequestStatus struct {
+	// StartTime indicates the time when the synchronization was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime indicates the time when the synchronization succeeded or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// ExpiryTime indicates the time after which the request is deleted, i.e. CompletionTime plus
+	// Spec.TTLSecondsAfterFinished. It is set once the synchronization completed.
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+
+	// Conditions describes the current condition information of the ContentLibrarySyncRequest.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (syncRequest *ContentLibrarySyncRequest) GetConditions() Conditions {
+	return syncRequest.Status.Conditions
+}
+
+func (syncRequest *ContentLibrarySyncRequest) SetConditions(conditions Conditions) {
+	syncRequest.Status.Conditions = conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=clsyncreq,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="ContentLibraryRef",type="string",JSONPath=".spec.contentLibraryRef"
+// +kubebuilder:printcolumn:name="Completed",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ContentLibrarySyncRequest is the schema for the content library sync request API.
+// It triggers the synchronization of a subscribed ContentLibrary, or of some of its items, with its publisher.
+// Triggering a synchronization does not require the right to update the library itself, so RBAC can grant
+// a persona the right to create ContentLibrarySyncRequests without the right to change library specs.
+type ContentLibrarySyncRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContentLibrarySyncRequestSpec   `json:"spec,omitempty"`
+	Status ContentLibrarySyncRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContentLibrarySyncRequestList contains a list of ContentLibrarySyncRequest.
+type ContentLibrarySyncRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContentLibrarySyncRequest `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ContentLibrarySyncRequest{}, &ContentLibrarySyncRequestList{})
+}
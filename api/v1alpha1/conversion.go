@@ -0,0 +1,111 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+// v1alpha1 is the conversion hub of the image registry API: every other version of a kind converts to and
+// from its v1alpha1 representation. The Hub methods satisfy the conversion.Hub interface of controller-runtime,
+// and the kinds of the other versions implement its conversion.Convertible interface with ConvertTo and
+// ConvertFrom methods taking the v1alpha1 kind. The round-trip tests of this package fuzz every kind, and the
+// conversions of the other versions are added to them so that no data is lost across versions.
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibrary) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItem) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ClusterContentLibrary) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ClusterContentLibraryList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ClusterContentLibraryItem) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ClusterContentLibraryItemList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryConfiguration) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryConfigurationList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemFileUpload) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemFileUploadList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemImportRequest) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemImportRequestList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryImportSet) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryImportSetList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemSummary) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemSummaryList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ClusterContentLibraryItemSummary) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ClusterContentLibraryItemSummaryList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ImageAlias) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ImageAliasList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemVersion) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemVersionList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryReplication) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryReplicationList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemValidationRequest) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemValidationRequestList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemVolumeRequest) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibraryItemVolumeRequestList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ImageFamily) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ImageFamilyList) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibrarySyncRequest) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ContentLibrarySyncRequestList) Hub() {}
@@ -0,0 +1,143 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1/install"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+)
+
+// fuzzIterations is the number of randomly populated objects of every kind that are round-tripped.
+const fuzzIterations = 100
+
+// fuzzSeed overrides the seed of the random objects if it is not 0.
+var fuzzSeed = flag.Int64("fuzz-seed", 0, "the seed of the randomly populated objects, or 0 for a random seed")
+
+// newFuzzSeed returns the seed of the random objects and logs it, so that a failure can be reproduced.
+func newFuzzSeed(t *testing.T) int64 {
+	seed := *fuzzSeed
+	if seed == 0 {
+		seed = rand.Int63()
+	}
+	t.Logf("fuzzing with the seed %d, set -fuzz-seed=%d to reproduce", seed, seed)
+	return seed
+}
+
+// hub is the conversion.Hub interface of controller-runtime.
+type hub interface {
+	runtime.Object
+	Hub()
+}
+
+// roundTrip converts a hub object to another representation and back.
+type roundTrip func(scheme *runtime.Scheme, obj hub) (runtime.Object, error)
+
+// roundTrips are the representations every kind must convert to and from without losing data. Once another
+// version of the API is added, its ConvertTo and ConvertFrom functions are tested here as a round-trip from
+// the hub through the other version.
+var roundTrips = map[string]roundTrip{
+	"json": func(scheme *runtime.Scheme, obj hub) (runtime.Object, error) {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		into, err := scheme.New(obj.GetObjectKind().GroupVersionKind())
+		if err != nil {
+			return nil, err
+		}
+		return into, json.Unmarshal(data, into)
+	},
+	"unstructured": func(scheme *runtime.Scheme, obj hub) (runtime.Object, error) {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		into, err := scheme.New(obj.GetObjectKind().GroupVersionKind())
+		if err != nil {
+			return nil, err
+		}
+		return into, runtime.DefaultUnstructuredConverter.FromUnstructured(u, into)
+	},
+	"deepcopy": func(_ *runtime.Scheme, obj hub) (runtime.Object, error) {
+		return obj.DeepCopyObject(), nil
+	},
+}
+
+func kinds(scheme *runtime.Scheme) []schema.GroupVersionKind {
+	var kinds []schema.GroupVersionKind
+	for gvk, typ := range scheme.AllKnownTypes() {
+		if gvk.GroupVersion() == v1alpha1.SchemeGroupVersion && typ.PkgPath() == reflect.TypeOf(v1alpha1.ContentLibrary{}).PkgPath() {
+			kinds = append(kinds, gvk)
+		}
+	}
+	return kinds
+}
+
+func TestKindsAreHubs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	for _, gvk := range kinds(scheme) {
+		obj, _ := scheme.New(gvk)
+		if _, ok := obj.(hub); !ok {
+			t.Errorf("%s is not a conversion hub", gvk.Kind)
+		}
+	}
+}
+
+func TestFuzzRoundTrip(t *testing.T) {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	seed := newFuzzSeed(t)
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(seed), runtimeserializer.NewCodecFactory(scheme))
+
+	for name, roundTrip := range roundTrips {
+		for _, gvk := range kinds(scheme) {
+			t.Run(name+"/"+gvk.Kind, func(t *testing.T) {
+				for i := 0; i < fuzzIterations; i++ {
+					obj, _ := scheme.New(gvk)
+					f.Fuzz(obj)
+					obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+					result, err := roundTrip(scheme, obj.(hub))
+					if err != nil {
+						t.Fatalf("failed to round-trip (seed %d): %v", seed, err)
+					}
+					if !equal(t, obj, result) {
+						t.Fatalf("the object changed in the round-trip (seed %d): %s", seed, diff.ObjectReflectDiff(obj, result))
+					}
+				}
+			})
+		}
+	}
+}
+
+// equal returns whether a and b are semantically equal, or have the same JSON representation, in which case
+// their differences, such as nil and empty slices, would be lost in transit anyway.
+func equal(t *testing.T, a, b runtime.Object) bool {
+	if apiequality.Semantic.DeepEqual(a, b) {
+		return true
+	}
+	aData, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("failed to encode %T: %v", a, err)
+	}
+	bData, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("failed to encode %T: %v", b, err)
+	}
+	return bytes.Equal(aData, bData)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RecordDeploy records that a VM was deployed from the ContentLibraryItem or ClusterContentLibraryItem at the
+// given time, by incrementing its Status.DeployCount and setting its Status.LastDeployTime. It returns false,
+// leaving item untouched, if item is of any other type.
+//
+// The consumers of library items, e.g. vm-operator, call RecordDeploy on the latest version of the item and
+// update the status subresource of the item with a plain update rather than a patch, so that concurrent
+// deployments conflict and are retried instead of losing increments. The content library operator preserves
+// both fields when it updates the status of the item, and the validating webhook rejects the changes to them
+// by users that are not trusted status writers.
+func RecordDeploy(item metav1.Object, at time.Time) bool {
+	deployTime := metav1.NewTime(at)
+
+	switch obj := item.(type) {
+	case *ContentLibraryItem:
+		obj.Status.DeployCount++
+		obj.Status.LastDeployTime = &deployTime
+	case *ClusterContentLibraryItem:
+		obj.Status.DeployCount++
+		obj.Status.LastDeployTime = &deployTime
+	default:
+		return false
+	}
+	return true
+}
+
+// DeployedSince returns true if a VM was deployed from the ContentLibraryItem or ClusterContentLibraryItem at
+// or after since, as recorded by RecordDeploy. Image catalog owners use it to find unused images to prune.
+// Objects of any other type were never deployed.
+func DeployedSince(item metav1.Object, since time.Time) bool {
+	var lastDeployTime *metav1.Time
+
+	switch obj := item.(type) {
+	case *ContentLibraryItem:
+		lastDeployTime = obj.Status.LastDeployTime
+	case *ClusterContentLibraryItem:
+		lastDeployTime = obj.Status.LastDeployTime
+	default:
+		return false
+	}
+	return lastDeployTime != nil && !lastDeployTime.Time.Before(since)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Deprecation describes a deprecated kind or field of the API, so that tools can detect and report the use of
+// deprecated API surface without parsing the doc comments of the types.
+type Deprecation struct {
+	// GroupVersionKind is the kind that is deprecated, or that has the deprecated field.
+	GroupVersionKind schema.GroupVersionKind
+
+	// FieldPath is the JSON path of the deprecated field within the kind, e.g. "status.cached". It is empty
+	// if the kind itself is deprecated.
+	FieldPath string
+
+	// Replacement is the JSON path of the field, or the kind, that replaces the deprecated one, if any.
+	Replacement string
+
+	// Message is a human readable explanation of the deprecation.
+	Message string
+}
+
+// deprecations lists the deprecated kinds and fields of this API version. Every entry must match a
+// "Deprecated:" paragraph in the doc comment of the kind or field.
+var deprecations = []Deprecation{
+	{
+		GroupVersionKind: SchemeGroupVersion.WithKind("ContentLibraryItem"),
+		FieldPath:        "status.cached",
+		Replacement:      "status.cacheStatus",
+		Message:          "status.cached does not report why the library item files are not on disk",
+	},
+	{
+		GroupVersionKind: SchemeGroupVersion.WithKind("ClusterContentLibraryItem"),
+		FieldPath:        "status.cached",
+		Replacement:      "status.cacheStatus",
+		Message:          "status.cached does not report why the library item files are not on disk",
+	},
+	{
+		GroupVersionKind: SchemeGroupVersion.WithKind("ContentLibrary"),
+		FieldPath:        "spec.create.subscription",
+		Replacement:      "spec.subscription",
+		Message:          "spec.create.subscription cannot be changed after the library is created",
+	},
+}
+
+// Deprecations returns the deprecated kinds and fields of this API version.
+func Deprecations() []Deprecation {
+	return append([]Deprecation(nil), deprecations...)
+}
+
+// DeprecationsOf returns the deprecated kind and fields of the given kind of this API version.
+func DeprecationsOf(kind string) []Deprecation {
+	var result []Deprecation
+	for _, deprecation := range deprecations {
+		if deprecation.GroupVersionKind.Kind == kind {
+			result = append(result, deprecation)
+		}
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Feature is the name of a feature of the content library operator that can be enabled or disabled.
+type Feature string
+
+const (
+	// FeatureWritableLibraries enables the modification of library items of writable libraries from
+	// Kubernetes, e.g. renaming items and uploading files.
+	FeatureWritableLibraries = Feature("WritableLibraries")
+
+	// FeatureItemImport enables ContentLibraryItemImportRequest and ContentLibraryImportSet resources.
+	FeatureItemImport = Feature("ItemImport")
+
+	// FeatureScanIntegration enables the integration with image scanners.
+	FeatureScanIntegration = Feature("ScanIntegration")
+)
+
+// DefaultFeatureGates are the known features and whether they are enabled by default.
+var DefaultFeatureGates = FeatureGates{
+	FeatureWritableLibraries: true,
+	FeatureItemImport:        false,
+	FeatureScanIntegration:   false,
+}
+
+// FeatureGates enables or disables features. Features that are not set fall back to DefaultFeatureGates.
+type FeatureGates map[Feature]bool
+
+// Enabled returns true if the feature is enabled, falling back to its default, or false if it is unknown.
+func (gates FeatureGates) Enabled(feature Feature) bool {
+	if enabled, ok := gates[feature]; ok {
+		return enabled
+	}
+	return DefaultFeatureGates[feature]
+}
+
+// String returns the feature gates in the "Feature1=true,Feature2=false" form, sorted by feature.
+func (gates FeatureGates) String() string {
+	pairs := make([]string, 0, len(gates))
+	for feature, enabled := range gates {
+		pairs = append(pairs, fmt.Sprintf("%s=%t", feature, enabled))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Validate returns an error if any of the feature gates is not a known feature.
+func (gates FeatureGates) Validate() error {
+	var unknown []string
+	for feature := range gates {
+		if _, ok := DefaultFeatureGates[feature]; !ok {
+			unknown = append(unknown, string(feature))
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown feature gates: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// ParseFeatureGates parses feature gates in the "Feature1=true,Feature2=false" form, as used in command
+// line flags. Unknown features are rejected.
+func ParseFeatureGates(value string) (FeatureGates, error) {
+	gates := FeatureGates{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("missing bool value for feature gate %q", pair)
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for feature gate %q: %w", parts[1], parts[0], err)
+		}
+		gates[Feature(strings.TrimSpace(parts[0]))] = enabled
+	}
+	if err := gates.Validate(); err != nil {
+		return nil, err
+	}
+	return gates, nil
+}
+
+// FeatureGatesFromConfiguration returns the feature gates set in the spec of the ContentLibraryConfiguration.
+// The configuration may be nil if it does not exist.
+func FeatureGatesFromConfiguration(config *ContentLibraryConfiguration) FeatureGates {
+	gates := FeatureGates{}
+	if config == nil {
+		return gates
+	}
+	for feature, enabled := range config.Spec.FeatureGates {
+		gates[Feature(feature)] = enabled
+	}
+	return gates
+}
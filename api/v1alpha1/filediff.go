@@ -0,0 +1,60 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"sort"
+	"strings"
+)
+
+// DiffFiles returns the changes between the files of two content versions of a library item, sorted by file
+// name. A file changed if its checksums differ. When the checksums cannot be compared, e.g. because one of
+// them is missing or they use different algorithms, a file changed if its version or size differ.
+func DiffFiles(previous, current []FileInfo) []FileChange {
+	previousFiles := make(map[string]FileInfo, len(previous))
+	for _, file := range previous {
+		previousFiles[file.Name] = file
+	}
+
+	var changes []FileChange
+	for _, file := range current {
+		old, ok := previousFiles[file.Name]
+		delete(previousFiles, file.Name)
+		switch {
+		case !ok:
+			changes = append(changes, FileChange{
+				Name:        file.Name,
+				Type:        FileChangeTypeAdded,
+				NewChecksum: file.Checksum,
+				NewSize:     file.Size,
+			})
+		case fileChanged(old, file):
+			changes = append(changes, FileChange{
+				Name:        file.Name,
+				Type:        FileChangeTypeChanged,
+				OldChecksum: old.Checksum,
+				NewChecksum: file.Checksum,
+				OldSize:     old.Size,
+				NewSize:     file.Size,
+			})
+		}
+	}
+	for _, file := range previousFiles {
+		changes = append(changes, FileChange{
+			Name:        file.Name,
+			Type:        FileChangeTypeRemoved,
+			OldChecksum: file.Checksum,
+			OldSize:     file.Size,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return changes
+}
+
+func fileChanged(old, current FileInfo) bool {
+	if old.Checksum != nil && current.Checksum != nil && old.Checksum.Algorithm == current.Checksum.Algorithm {
+		return !strings.EqualFold(old.Checksum.Value, current.Checksum.Value)
+	}
+	return old.Version != current.Version || old.Size != current.Size
+}
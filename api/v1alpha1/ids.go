@@ -0,0 +1,81 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var uuidRegexp = regexp.MustCompile(UUIDPattern)
+
+// LibraryID is the vCenter UUID of a content library. It serializes as a plain string.
+// The schema of every field of this type checks that it matches UUIDPattern.
+// +kubebuilder:validation:MaxLength=36
+// +kubebuilder:validation:XValidation:rule="self.matches('^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$')",message="must be a UUID of the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+type LibraryID string
+
+// ParseLibraryID parses the vCenter UUID of a content library, ignoring surrounding whitespace.
+func ParseLibraryID(s string) (LibraryID, error) {
+	id := LibraryID(strings.TrimSpace(s))
+	if !id.IsValid() {
+		return "", fmt.Errorf("invalid library ID %q: %s", s, UUIDFormatMessage)
+	}
+	return id, nil
+}
+
+// IsValid returns true if the library ID is a well-formed UUID.
+func (id LibraryID) IsValid() bool {
+	return uuidRegexp.MatchString(string(id))
+}
+
+// Normalize returns the library ID in the canonical form of NormalizeUUID.
+func (id LibraryID) Normalize() LibraryID {
+	return LibraryID(NormalizeUUID(string(id)))
+}
+
+// String returns the library ID as a string.
+func (id LibraryID) String() string {
+	return string(id)
+}
+
+// Equal returns true if both library IDs identify the same library, regardless of their casing.
+func (id LibraryID) Equal(other LibraryID) bool {
+	return strings.EqualFold(string(id), string(other))
+}
+
+// ItemID is the vCenter UUID of a content library item. It serializes as a plain string.
+// The schema of every field of this type checks that it matches UUIDPattern.
+// +kubebuilder:validation:MaxLength=36
+// +kubebuilder:validation:XValidation:rule="self.matches('^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$')",message="must be a UUID of the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+type ItemID string
+
+// ParseItemID parses the vCenter UUID of a content library item, ignoring surrounding whitespace.
+func ParseItemID(s string) (ItemID, error) {
+	id := ItemID(strings.TrimSpace(s))
+	if !id.IsValid() {
+		return "", fmt.Errorf("invalid item ID %q: %s", s, UUIDFormatMessage)
+	}
+	return id, nil
+}
+
+// IsValid returns true if the item ID is a well-formed UUID.
+func (id ItemID) IsValid() bool {
+	return uuidRegexp.MatchString(string(id))
+}
+
+// Normalize returns the item ID in the canonical form of NormalizeUUID.
+func (id ItemID) Normalize() ItemID {
+	return ItemID(NormalizeUUID(string(id)))
+}
+
+// String returns the item ID as a string.
+func (id ItemID) String() string {
+	return string(id)
+}
+
+// Equal returns true if both item IDs identify the same library item, regardless of their casing.
+func (id ItemID) Equal(other ItemID) bool {
+	return strings.EqualFold(string(id), string(other))
+}
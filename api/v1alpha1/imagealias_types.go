@@ -0,0 +1,95 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ImageAliasConditionReady indicates whether the target library item of an ImageAlias exists and is ready.
+	ImageAliasConditionReady = ConditionReady
+
+	// TargetNotFoundReason documents that the target library item of an ImageAlias does not exist.
+	TargetNotFoundReason = "TargetNotFound"
+
+	// TargetNotReadyReason documents that the target library item of an ImageAlias is not ready.
+	TargetNotReadyReason = "TargetNotReady"
+)
+
+// ImageAliasTarget refers to the library item an ImageAlias resolves to.
+type ImageAliasTarget struct {
+	// Kind is the kind of the library item.
+	// Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+	// +kubebuilder:validation:Enum=ContentLibraryItem;ClusterContentLibraryItem
+	// +required
+	Kind string `json:"kind"`
+
+	// Name is the name of the library item. A ContentLibraryItem must be in the same namespace as the ImageAlias.
+	// +required
+	Name string `json:"name"`
+}
+
+// ImageAliasSpec defines the desired state of an ImageAlias.
+type ImageAliasSpec struct {
+	// Target refers to the library item the alias resolves to. It can be changed to move the alias to a newer
+	// library item, e.g. after an image was republished under a new UUID.
+	// +required
+	Target ImageAliasTarget `json:"target"`
+}
+
+// ImageAliasStatus defines the observed state of an ImageAlias.
+type ImageAliasStatus struct {
+	// UUID is the vCenter identifier of the library item the alias currently resolves to.
+	// +optional
+	UUID ItemID `json:"uuid,omitempty"`
+
+	// ContentVersion is the content version of the library item the alias currently resolves to.
+	// +optional
+	ContentVersion string `json:"contentVersion,omitempty"`
+
+	// Conditions describes the current condition information of the ImageAlias.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (imageAlias *ImageAlias) GetConditions() Conditions {
+	return imageAlias.Status.Conditions
+}
+
+func (imageAlias *ImageAlias) SetConditions(conditions Conditions) {
+	imageAlias.Status.Conditions = conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=imgalias,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="TargetKind",type="string",JSONPath=".spec.target.kind"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.target.name"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ImageAlias is the schema for the image alias API.
+// An ImageAlias gives a library item a short, stable name, e.g. "ubuntu-22.04", that VM specs can refer to
+// instead of the generated name of the library item resource.
+type ImageAlias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageAliasSpec   `json:"spec,omitempty"`
+	Status ImageAliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageAliasList contains a list of ImageAlias.
+type ImageAliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageAlias `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ImageAlias{}, &ImageAliasList{})
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ImageFamilyConditionReady indicates whether an ImageFamily resolves to a ready library item.
+	ImageFamilyConditionReady = ConditionReady
+
+	// NoMatchingItemsReason documents that no library item is a member of an ImageFamily.
+	NoMatchingItemsReason = "NoMatchingItems"
+
+	// NoReadyItemsReason documents that none of the members of an ImageFamily is ready.
+	NoReadyItemsReason = "NoReadyItems"
+)
+
+// ImageFamilySpec defines the desired state of an ImageFamily.
+// At least one of NamePattern and Selector must be set. If both are set, the members of the family must
+// match both.
+// +kubebuilder:validation:XValidation:rule="has(self.namePattern) || has(self.selector)",message="at least one of namePattern and selector must be set"
+type ImageFamilySpec struct {
+	// ItemKind is the kind of the library items that are members of the family. ContentLibraryItems must be
+	// in the same namespace as the ImageFamily.
+	// Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+	// +kubebuilder:validation:Enum=ContentLibraryItem;ClusterContentLibraryItem
+	// +required
+	ItemKind string `json:"itemKind"`
+
+	// NamePattern is a shell pattern, e.g. "photon-4-*", matched against the name of the library items in
+	// vCenter. The pattern syntax is the one of the Go path.Match function.
+	// +optional
+	NamePattern string `json:"namePattern,omitempty"`
+
+	// Selector selects the library items that are members of the family by their labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// ImageFamilyStatus defines the observed state of an ImageFamily.
+type ImageFamilyStatus struct {
+	// Latest refers to the latest ready member of the family, i.e. the ready member that was created last in
+	// vCenter. VM specs that request the latest image of the family are resolved to it.
+	// +optional
+	Latest *LibraryItemReference `json:"latest,omitempty"`
+
+	// LatestUUID is the vCenter identifier of the latest ready member of the family.
+	// +optional
+	LatestUUID ItemID `json:"latestUUID,omitempty"`
+
+	// LatestContentVersion is the content version of the latest ready member of the family.
+	// +optional
+	LatestContentVersion string `json:"latestContentVersion,omitempty"`
+
+	// Members is the number of library items that are members of the family, whether they are ready or not.
+	// +optional
+	Members int32 `json:"members,omitempty"`
+
+	// Conditions describes the current condition information of the ImageFamily.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (imageFamily *ImageFamily) GetConditions() Conditions {
+	return imageFamily.Status.Conditions
+}
+
+func (imageFamily *ImageFamily) SetConditions(conditions Conditions) {
+	imageFamily.Status.Conditions = conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=imgfamily,categories=imageregistry;vmware
+// +kubebuilder:printcolumn:name="ItemKind",type="string",JSONPath=".spec.itemKind"
+// +kubebuilder:printcolumn:name="NamePattern",type="string",JSONPath=".spec.namePattern"
+// +kubebuilder:printcolumn:name="Latest",type="string",JSONPath=".status.latest.name"
+// +kubebuilder:printcolumn:name="Members",type="integer",JSONPath=".status.members"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ImageFamily is the schema for the image family API.
+// An ImageFamily groups the library items of a line of images, e.g. all the "photon-4-*" items, and
+// resolves to the latest ready one, so that VM specs can request the latest image of a family without
+// resolving it themselves.
+type ImageFamily struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageFamilySpec   `json:"spec,omitempty"`
+	Status ImageFamilyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageFamilyList contains a list of ImageFamily.
+type ImageFamilyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageFamily `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&ImageFamily{}, &ImageFamilyList{})
+}
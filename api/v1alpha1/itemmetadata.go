@@ -0,0 +1,93 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// ItemMetadataVariables are the variables the keys and values of an ItemMetadataTemplate are rendered with.
+type ItemMetadataVariables struct {
+	// ItemName is the name of the library item in vCenter.
+	ItemName string
+
+	// Type is the type of the library item, e.g. "Ovf".
+	Type ContentLibraryItemType
+
+	// UUID is the identifier of the library item in vCenter.
+	UUID ItemID
+
+	// ContentVersion is the content version of the library item.
+	ContentVersion string
+
+	// LibraryName is the name of the library resource the library item belongs to.
+	LibraryName string
+}
+
+// ItemMetadataVariablesOf returns the variables an ItemMetadataTemplate is rendered with for item.
+func ItemMetadataVariablesOf(item *ContentLibraryItem) ItemMetadataVariables {
+	return ItemMetadataVariables{
+		ItemName:       item.Status.Name,
+		Type:           item.Status.Type,
+		UUID:           item.Spec.UUID,
+		ContentVersion: item.Status.ContentVersion,
+		LibraryName:    item.Status.ContentLibraryRef.Name,
+	}
+}
+
+// RenderItemMetadataTemplate returns the labels and annotations described by the template for a library item
+// with the given variables. It returns an error if a key or value is not a valid template, refers to an
+// unknown variable, or if two keys render to the same key. The rendered labels and annotations are not
+// validated otherwise.
+func RenderItemMetadataTemplate(tmpl *ItemMetadataTemplate, vars ItemMetadataVariables) (labels,
+	annotations map[string]string, err error) {
+	if tmpl == nil {
+		return nil, nil, nil
+	}
+	if labels, err = renderMetadata(tmpl.Labels, vars); err != nil {
+		return nil, nil, fmt.Errorf("labels: %w", err)
+	}
+	if annotations, err = renderMetadata(tmpl.Annotations, vars); err != nil {
+		return nil, nil, fmt.Errorf("annotations: %w", err)
+	}
+	return labels, annotations, nil
+}
+
+func renderMetadata(metadata map[string]string, vars ItemMetadataVariables) (map[string]string, error) {
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+	rendered := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		renderedKey, err := renderMetadataText(key, vars)
+		if err != nil {
+			return nil, err
+		}
+		renderedValue, err := renderMetadataText(value, vars)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := rendered[renderedKey]; ok {
+			return nil, fmt.Errorf("more than one key renders to %q", renderedKey)
+		}
+		rendered[renderedKey] = renderedValue
+	}
+	return rendered, nil
+}
+
+func renderMetadataText(text string, vars ItemMetadataVariables) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tmpl, err := template.New("").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, vars); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"path"
+	"strings"
+)
+
+// vCenterItemTypes maps the library item types reported by vCenter, lowercased, to the types of this API.
+var vCenterItemTypes = map[string]ContentLibraryItemType{
+	"ovf": ContentLibraryItemTypeOvf,
+	"iso": ContentLibraryItemTypeIso,
+}
+
+// fileExtensionItemTypes maps the extensions of the files of a library item to the type of the item, for the
+// items vCenter reports no type for.
+var fileExtensionItemTypes = map[string]ContentLibraryItemType{
+	".ovf": ContentLibraryItemTypeOvf,
+	".ova": ContentLibraryItemTypeOvf,
+	".iso": ContentLibraryItemTypeIso,
+}
+
+// DetectItemType returns the type of a library item from the type reported by vCenter, rawType, which is also
+// recorded in the RawType field of the status of the item. vCenter reports no type for the items uploaded
+// without one, in which case the extensions of the names of the files of the item hint at its type, and items
+// without any hint hold arbitrary files. Types vCenter reports but this API does not support are "Unknown",
+// rather than being rejected, so that the items remain visible.
+func DetectItemType(rawType string, fileNames []string) ContentLibraryItemType {
+	rawType = strings.TrimSpace(rawType)
+	if rawType == "" {
+		for _, name := range fileNames {
+			if itemType, ok := fileExtensionItemTypes[strings.ToLower(path.Ext(name))]; ok {
+				return itemType
+			}
+		}
+		return ContentLibraryItemTypeFile
+	}
+
+	if itemType, ok := vCenterItemTypes[strings.ToLower(rawType)]; ok {
+		return itemType
+	}
+	return ContentLibraryItemTypeUnknown
+}
+
+// IsSupported returns true if the library item type is supported by this API, i.e. it is not "Unknown".
+func (t ContentLibraryItemType) IsSupported() bool {
+	switch t {
+	case ContentLibraryItemTypeOvf, ContentLibraryItemTypeIso, ContentLibraryItemTypeFile:
+		return true
+	}
+	return false
+}
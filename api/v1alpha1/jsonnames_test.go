@@ -0,0 +1,63 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1_test
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1/install"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// lowerCamelCase matches JSON field names such as "uuid", "subscriptionURL" or "vCenterInstanceUUID".
+var lowerCamelCase = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+
+// TestJSONFieldNames enforces the naming convention of the API on every field of every kind: JSON field names
+// are lowerCamelCase and spell the name of their Go field, so that e.g. a field UUID is not serialized as
+// "URL" or "itemUUID".
+func TestJSONFieldNames(t *testing.T) {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+
+	pkgPath := reflect.TypeOf(v1alpha1.ContentLibrary{}).PkgPath()
+	seen := map[reflect.Type]bool{}
+	var check func(typ reflect.Type)
+	check = func(typ reflect.Type) {
+		for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Map {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct || typ.PkgPath() != pkgPath || seen[typ] {
+			return
+		}
+		seen[typ] = true
+
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			switch {
+			case name == "-" || f.PkgPath != "":
+				continue
+			case name == "":
+				if !f.Anonymous {
+					t.Errorf("%s.%s has no JSON field name", typ.Name(), f.Name)
+				}
+			case !lowerCamelCase.MatchString(name):
+				t.Errorf("%s.%s has JSON field name %q, which is not lowerCamelCase", typ.Name(), f.Name, name)
+			case name == "metadata":
+				// The object and list metadata of the kinds follow the Kubernetes API conventions.
+			case !strings.EqualFold(name, f.Name):
+				t.Errorf("%s.%s has JSON field name %q, which does not spell its Go field name", typ.Name(), f.Name, name)
+			}
+			check(f.Type)
+		}
+	}
+
+	for _, gvk := range kinds(scheme) {
+		obj, _ := scheme.New(gvk)
+		check(reflect.TypeOf(obj))
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// ItemTypeLabelKey is the label that holds the type of a library item, e.g. "Ovf" or "Iso".
+	ItemTypeLabelKey = GroupName + "/item-type"
+
+	// LibraryNameLabelKey is the label that holds the name of the ContentLibrary or ClusterContentLibrary
+	// resource a library item belongs to.
+	LibraryNameLabelKey = GroupName + "/library-name"
+
+	// CachedLabelKey is the label that indicates whether the files of a library item are on disk in vCenter.
+	CachedLabelKey = GroupName + "/cached"
+
+	// DefaultLibraryLabelKey is the label that designates a ContentLibrary as the default target of its
+	// namespace for image uploads and imports. The only recognized value is "true", and at most one
+	// ContentLibrary per namespace may have it.
+	DefaultLibraryLabelKey = GroupName + "/default"
+)
+
+// SyncLabels sets the well-known labels of a ContentLibraryItem or ClusterContentLibraryItem from its status,
+// so that label selectors such as "all the ISO items of library X" work consistently. Labels whose value
+// cannot be derived are removed. It returns true if the labels of the item were changed.
+// Objects of any other type are left untouched.
+func SyncLabels(item metav1.Object) bool {
+	var itemType ContentLibraryItemType
+	var libraryName string
+	var cached bool
+
+	switch obj := item.(type) {
+	case *ContentLibraryItem:
+		itemType, libraryName, cached = obj.Status.Type, obj.Status.ContentLibraryRef.Name, obj.Status.Cached
+	case *ClusterContentLibraryItem:
+		itemType, libraryName, cached = obj.Status.Type, obj.Status.ClusterContentLibraryRef, obj.Status.Cached
+	default:
+		return false
+	}
+
+	desired := map[string]string{
+		ItemTypeLabelKey:    string(itemType),
+		LibraryNameLabelKey: libraryName,
+		CachedLabelKey:      strconv.FormatBool(cached),
+	}
+
+	labels := item.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	changed := false
+	for key, value := range desired {
+		current, ok := labels[key]
+		switch {
+		case value == "" || len(validation.IsValidLabelValue(value)) > 0:
+			if ok {
+				delete(labels, key)
+				changed = true
+			}
+		case !ok || current != value:
+			labels[key] = value
+			changed = true
+		}
+	}
+
+	if changed {
+		item.SetLabels(labels)
+	}
+	return changed
+}
+
+// IsDefaultContentLibrary returns true if the library is designated as the default library of its namespace.
+func IsDefaultContentLibrary(library *ContentLibrary) bool {
+	return library.Labels[DefaultLibraryLabelKey] == "true"
+}
+
+// SetDefaultContentLibrary designates the library as the default library of its namespace, or removes the
+// designation.
+func SetDefaultContentLibrary(library *ContentLibrary, isDefault bool) {
+	if !isDefault {
+		delete(library.Labels, DefaultLibraryLabelKey)
+		return
+	}
+	if library.Labels == nil {
+		library.Labels = map[string]string{}
+	}
+	library.Labels[DefaultLibraryLabelKey] = "true"
+}
+
+// DefaultContentLibrary returns the library designated as the default library among the libraries of a
+// namespace, or nil if there is none.
+func DefaultContentLibrary(libraries []ContentLibrary) *ContentLibrary {
+	for i := range libraries {
+		if IsDefaultContentLibrary(&libraries[i]) {
+			return &libraries[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// LeaseAnnotationPrefix is the prefix of the annotations that lease the content of a ContentLibraryItem or
+// ClusterContentLibraryItem. A consumer that is actively using the content of an item, e.g. cloning a VM from
+// it, sets the annotation "lease.imageregistry.vmware.com/<holder>" to the RFC 3339 time at which its lease
+// expires. While an item has an unexpired lease, the content library operator defers replacing or evicting
+// the content of the item.
+const LeaseAnnotationPrefix = "lease." + GroupName + "/"
+
+// AcquireLease sets or renews the lease of holder on the content of the item until expiry.
+// The holder must be a valid annotation name, e.g. the name of the consuming controller.
+func AcquireLease(item metav1.Object, holder string, expiry time.Time) error {
+	key := LeaseAnnotationPrefix + holder
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return fmt.Errorf("invalid lease holder %q: %s", holder, strings.Join(errs, ", "))
+	}
+
+	annotations := item.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = expiry.UTC().Format(time.RFC3339)
+	item.SetAnnotations(annotations)
+	return nil
+}
+
+// ReleaseLease removes the lease of holder on the content of the item.
+func ReleaseLease(item metav1.Object, holder string) {
+	annotations := item.GetAnnotations()
+	if _, ok := annotations[LeaseAnnotationPrefix+holder]; !ok {
+		return
+	}
+	delete(annotations, LeaseAnnotationPrefix+holder)
+	item.SetAnnotations(annotations)
+}
+
+// ActiveLeases returns the expiry time of every lease on the content of the item that has not expired at now,
+// keyed by holder. Leases with an expiry time that cannot be parsed are ignored.
+func ActiveLeases(item metav1.Object, now time.Time) map[string]time.Time {
+	leases := map[string]time.Time{}
+	for key, value := range item.GetAnnotations() {
+		if !strings.HasPrefix(key, LeaseAnnotationPrefix) {
+			continue
+		}
+		expiry, err := time.Parse(time.RFC3339, value)
+		if err != nil || !expiry.After(now) {
+			continue
+		}
+		leases[strings.TrimPrefix(key, LeaseAnnotationPrefix)] = expiry
+	}
+	return leases
+}
+
+// HasActiveLease returns true if the content of the item has at least one lease that has not expired at now.
+func HasActiveLease(item metav1.Object, now time.Time) bool {
+	return len(ActiveLeases(item, now)) > 0
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// UUIDAnnotationKey is the annotation that records the vCenter UUID an object name was derived from,
+	// since NameFromUUID is not reversible.
+	UUIDAnnotationKey = GroupName + "/uuid"
+
+	// ContentLibraryItemNamePrefix is the name prefix for ContentLibraryItem resources derived from a vCenter UUID.
+	ContentLibraryItemNamePrefix = "clitem"
+
+	// ClusterContentLibraryItemNamePrefix is the name prefix for ClusterContentLibraryItem resources derived
+	// from a vCenter UUID.
+	ClusterContentLibraryItemNamePrefix = "cclitem"
+
+	// nameHashLength is the number of hex characters of the UUID hash used in a derived name.
+	nameHashLength = 17
+)
+
+// NameFromUUID returns a deterministic resource name for the given vCenter UUID so that all the controllers
+// generate identical names for the same vCenter object. The UUID is normalized before hashing, so names do
+// not depend on the casing or surrounding whitespace of the UUID.
+func NameFromUUID(uuid, prefix string) string {
+	sum := sha256.Sum256([]byte(NormalizeUUID(uuid)))
+	hash := hex.EncodeToString(sum[:])[:nameHashLength]
+	if prefix == "" {
+		return hash
+	}
+	return prefix + "-" + hash
+}
+
+// NormalizeUUID returns the canonical form of a vCenter UUID: lowercased, without surrounding whitespace.
+// vCenter UUIDs are case-insensitive, so UUIDs that only differ in casing identify the same vCenter object.
+func NormalizeUUID(uuid string) string {
+	return strings.ToLower(strings.TrimSpace(uuid))
+}
+
+// NameMatchesUUID returns true if name is the resource name NameFromUUID derives for the given UUID and prefix.
+func NameMatchesUUID(name, uuid, prefix string) bool {
+	return name == NameFromUUID(uuid, prefix)
+}
+
+// SetUUIDAnnotation records the vCenter UUID an object name was derived from on the object.
+func SetUUIDAnnotation(obj metav1.Object, uuid string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[UUIDAnnotationKey] = uuid
+	obj.SetAnnotations(annotations)
+}
+
+// UUIDFromAnnotation returns the vCenter UUID recorded on the object, and whether it was present.
+func UUIDFromAnnotation(obj metav1.Object) (string, bool) {
+	uuid, ok := obj.GetAnnotations()[UUIDAnnotationKey]
+	return uuid, ok
+}
+
+// ContentLibraryItemVersionName returns the name of the ContentLibraryItemVersion of the given content version
+// of a ContentLibraryItem.
+func ContentLibraryItemVersionName(itemName, contentVersion string) string {
+	return itemName + "-v" + contentVersion
+}
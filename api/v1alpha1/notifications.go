@@ -0,0 +1,100 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NotificationType is a constant type that indicates the type of a vCenter content library notification.
+// The notification type is also used as the reason of the Kubernetes Events the notifications are
+// converted to.
+// +kubebuilder:validation:Enum=LibraryUpdated;LibraryDeleted;ItemCreated;ItemUpdated;ItemDeleted
+type NotificationType string
+
+const (
+	// NotificationTypeLibraryUpdated indicates that a library was updated in vCenter.
+	NotificationTypeLibraryUpdated = NotificationType("LibraryUpdated")
+
+	// NotificationTypeLibraryDeleted indicates that a library was deleted in vCenter.
+	NotificationTypeLibraryDeleted = NotificationType("LibraryDeleted")
+
+	// NotificationTypeItemCreated indicates that a library item was created in vCenter.
+	NotificationTypeItemCreated = NotificationType("ItemCreated")
+
+	// NotificationTypeItemUpdated indicates that the metadata or the content of a library item was updated in vCenter.
+	NotificationTypeItemUpdated = NotificationType("ItemUpdated")
+
+	// NotificationTypeItemDeleted indicates that a library item was deleted in vCenter.
+	NotificationTypeItemDeleted = NotificationType("ItemDeleted")
+)
+
+// NotificationPolicy defines which vCenter content library notifications the content library operator
+// propagates to Kubernetes.
+type NotificationPolicy struct {
+	// Enabled indicates whether vCenter content library notifications are propagated.
+	// +required
+	Enabled bool `json:"enabled"`
+
+	// Types are the notification types that are propagated. If empty, all the types are propagated.
+	// +listType=set
+	// +optional
+	Types []NotificationType `json:"types,omitempty"`
+
+	// EmitEvents indicates whether notifications are recorded as Kubernetes Events on the affected library
+	// and library item resources, in addition to updating their conditions.
+	// +optional
+	EmitEvents bool `json:"emitEvents,omitempty"`
+}
+
+// Propagates returns true if the policy propagates notifications of the given type.
+func (policy *NotificationPolicy) Propagates(notificationType NotificationType) bool {
+	if policy == nil || !policy.Enabled {
+		return false
+	}
+	if len(policy.Types) == 0 {
+		return true
+	}
+	for _, t := range policy.Types {
+		if t == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
+// LibraryNotification is the payload of a vCenter content library notification, with a stable schema so
+// that all the consumers of the notifications interpret them identically.
+type LibraryNotification struct {
+	// Type is the type of the notification.
+	Type NotificationType `json:"type"`
+
+	// Time indicates the time when the change happened in vCenter.
+	Time metav1.Time `json:"time"`
+
+	// VCenterInstanceUUID is the instance UUID of the vCenter that sent the notification.
+	VCenterInstanceUUID string `json:"vCenterInstanceUUID,omitempty"`
+
+	// LibraryID is the identifier of the library in vCenter.
+	LibraryID LibraryID `json:"libraryID"`
+
+	// ItemID is the identifier of the library item in vCenter, for the item notification types.
+	ItemID ItemID `json:"itemID,omitempty"`
+
+	// Message is a human-readable description of the change.
+	Message string `json:"message,omitempty"`
+}
+
+// EventReason returns the reason of the Kubernetes Event the notification is converted to.
+func (notification *LibraryNotification) EventReason() string {
+	return string(notification.Type)
+}
+
+// IsItemNotification returns true if the notification is about a library item rather than a library.
+func (notification *LibraryNotification) IsItemNotification() bool {
+	switch notification.Type {
+	case NotificationTypeItemCreated, NotificationTypeItemUpdated, NotificationTypeItemDeleted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The kinds of the library resources that own library items.
+const (
+	ContentLibraryKind        = "ContentLibrary"
+	ClusterContentLibraryKind = "ClusterContentLibrary"
+)
+
+// SetContentLibraryOwnerReference sets library as the controller owner of the ContentLibraryItem, so that the
+// item is garbage collected when the library is deleted. The library must be in the namespace of the item.
+// Any other controller owner reference of the item is replaced.
+func SetContentLibraryOwnerReference(item *ContentLibraryItem, library *ContentLibrary) error {
+	if item.Namespace != library.Namespace {
+		return fmt.Errorf("ContentLibrary %s/%s cannot own ContentLibraryItem %s/%s in another namespace",
+			library.Namespace, library.Name, item.Namespace, item.Name)
+	}
+	setControllerOwnerReference(item, library, ContentLibraryKind)
+	return nil
+}
+
+// SetClusterContentLibraryOwnerReference sets library as the controller owner of the ClusterContentLibraryItem,
+// so that the item is garbage collected when the library is deleted.
+// Any other controller owner reference of the item is replaced.
+func SetClusterContentLibraryOwnerReference(item *ClusterContentLibraryItem, library *ClusterContentLibrary) {
+	setControllerOwnerReference(item, library, ClusterContentLibraryKind)
+}
+
+// LibraryOwnerReference returns the controller owner reference of the item if it refers to a ContentLibrary or
+// ClusterContentLibrary, or nil otherwise.
+func LibraryOwnerReference(item metav1.Object) *metav1.OwnerReference {
+	ref := metav1.GetControllerOf(item)
+	if ref == nil || ref.APIVersion != SchemeGroupVersion.String() {
+		return nil
+	}
+	if ref.Kind != ContentLibraryKind && ref.Kind != ClusterContentLibraryKind {
+		return nil
+	}
+	return ref
+}
+
+func setControllerOwnerReference(item, library metav1.Object, kind string) {
+	ref := *metav1.NewControllerRef(library, SchemeGroupVersion.WithKind(kind))
+
+	var refs []metav1.OwnerReference
+	for _, existing := range item.GetOwnerReferences() {
+		if existing.UID == ref.UID || (existing.Controller != nil && *existing.Controller) {
+			continue
+		}
+		refs = append(refs, existing)
+	}
+	item.SetOwnerReferences(append(refs, ref))
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultTTLSecondsAfterFinished is the default number of seconds after which a completed request, i.e. a
+// ContentLibraryItemImportRequest, ContentLibrarySyncRequest, ContentLibraryItemValidationRequest,
+// ContentLibraryItemFileUpload or ContentLibraryItemVolumeRequest, is deleted. The API server applies it
+// through the kubebuilder default marker of their TTLSecondsAfterFinished fields, which must be kept in sync
+// with it.
+const DefaultTTLSecondsAfterFinished = int32(24 * 60 * 60)
+
+// ExpiryTime returns the time after which a request that completed at completionTime is deleted, given its
+// TTLSecondsAfterFinished. It returns nil if the request has not completed, or if ttlSecondsAfterFinished is
+// nil and the request is never deleted.
+func ExpiryTime(completionTime *metav1.Time, ttlSecondsAfterFinished *int32) *metav1.Time {
+	if completionTime == nil || ttlSecondsAfterFinished == nil {
+		return nil
+	}
+	expiryTime := metav1.NewTime(completionTime.Add(time.Duration(*ttlSecondsAfterFinished) * time.Second))
+	return &expiryTime
+}
+
+// IsExpired returns true if a request with the given Status.ExpiryTime must be deleted at now.
+func IsExpired(expiryTime *metav1.Time, now time.Time) bool {
+	return expiryTime != nil && !now.Before(expiryTime.Time)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpiryTime(t *testing.T) {
+	completed := metav1.NewTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	ttl := func(value int32) *int32 { return &value }
+
+	tests := []struct {
+		name           string
+		completionTime *metav1.Time
+		ttl            *int32
+		expected       *time.Time
+	}{
+		{name: "a request that has not finished", ttl: ttl(60)},
+		{name: "a finished request without a TTL", completionTime: &completed},
+		{name: "a finished request", completionTime: &completed, ttl: ttl(60), expected: timePtr(completed.Add(time.Minute))},
+		{name: "a finished request with a zero TTL", completionTime: &completed, ttl: ttl(0), expected: &completed.Time},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiryTime := v1alpha1.ExpiryTime(tt.completionTime, tt.ttl)
+			switch {
+			case tt.expected == nil && expiryTime != nil:
+				t.Errorf("expected no expiry time, got %v", expiryTime)
+			case tt.expected != nil && (expiryTime == nil || !expiryTime.Time.Equal(*tt.expected)):
+				t.Errorf("expected the expiry time %v, got %v", tt.expected, expiryTime)
+			}
+		})
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	expiryTime := metav1.NewTime(time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+	if v1alpha1.IsExpired(nil, expiryTime.Time) {
+		t.Error("expected a request without an expiry time never to expire")
+	}
+	if v1alpha1.IsExpired(&expiryTime, expiryTime.Add(-time.Second)) {
+		t.Error("expected the request not to expire before its expiry time")
+	}
+	if !v1alpha1.IsExpired(&expiryTime, expiryTime.Time) {
+		t.Error("expected the request to expire at its expiry time")
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
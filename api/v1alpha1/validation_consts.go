@@ -0,0 +1,109 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package v1alpha1
+
+// The patterns checked by the CEL validation rules in the CRD schemas.
+const (
+	// UUIDPattern matches a vCenter UUID, e.g. "3f9b9d2e-5c1a-4d8e-9f2b-1a2b3c4d5e6f".
+	UUIDPattern = "^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"
+
+	// URLPattern matches an HTTP or HTTPS URL.
+	URLPattern = "^https?://"
+
+	// ThumbprintPattern matches a SHA-1 certificate thumbprint, e.g. "AB:CD:...:EF".
+	ThumbprintPattern = "^([0-9A-Fa-f]{2}:){19}[0-9A-Fa-f]{2}$"
+)
+
+// The messages returned by the CEL validation rules in the CRD schemas. Webhooks that duplicate these
+// checks should return the same messages so users see consistent errors regardless of which layer
+// rejected the request.
+const (
+	// UUIDImmutableMessage is returned when the spec.uuid of a resource is changed.
+	UUIDImmutableMessage = "uuid is immutable"
+
+	// UUIDFormatMessage is returned when a UUID field does not match UUIDPattern.
+	UUIDFormatMessage = "must be a UUID of the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+	// URLFormatMessage is returned when a URL field does not match URLPattern.
+	URLFormatMessage = "must be an http or https URL"
+
+	// VCenterRefImmutableMessage is returned when the spec.vCenterRef of a library is changed.
+	VCenterRefImmutableMessage = "vCenterRef is immutable"
+
+	// ContentLibraryItemRefImmutableMessage is returned when the spec.contentLibraryItemRef of a
+	// ContentLibraryItemFileUpload is changed.
+	ContentLibraryItemRefImmutableMessage = "contentLibraryItemRef is immutable"
+
+	// SubscribedLibraryWritableMessage is returned when a library of the "Subscribed" type is marked writable.
+	SubscribedLibraryWritableMessage = "a library of the Subscribed type cannot be writable"
+
+	// PublishNonLocalLibraryMessage is returned when the publication of a library that is not of the "Local"
+	// type is set.
+	PublishNonLocalLibraryMessage = "only a library of the Local type can be published"
+
+	// PublishPasswordRequiredMessage is returned when Basic publish authentication has no password Secret.
+	PublishPasswordRequiredMessage = "passwordSecretRef is required for Basic authentication"
+
+	// StoragePolicyImmutableMessage is returned when the spec.storagePolicyID of a library is changed.
+	StoragePolicyImmutableMessage = "storagePolicyID is immutable"
+
+	// StorageClassImmutableMessage is returned when the spec.storageClassName of a library is changed.
+	StorageClassImmutableMessage = "storageClassName is immutable"
+
+	// StoragePolicyAndClassMessage is returned when both spec.storagePolicyID and spec.storageClassName of a
+	// library are set.
+	StoragePolicyAndClassMessage = "storagePolicyID and storageClassName are mutually exclusive"
+
+	// StorageWritableCreatedOnlyMessage is returned when spec.storagePolicyID or spec.storageClassName is set
+	// for a library that is not writable or was not created by the operator.
+	StorageWritableCreatedOnlyMessage = "storagePolicyID and storageClassName can only be set for a writable library created by the operator"
+
+	// LibraryModeMessage is returned when neither or both of spec.uuid and spec.create of a library are set.
+	LibraryModeMessage = "exactly one of uuid and create must be set"
+
+	// LibraryModeImmutableMessage is returned when a library switches between being adopted and being created.
+	LibraryModeImmutableMessage = "a library cannot switch between being adopted and being created"
+
+	// CreateImmutableMessage is returned when the spec.create of a library is changed.
+	CreateImmutableMessage = "create is immutable"
+
+	// DeletionPolicyCreatedOnlyMessage is returned when the "Delete" deletion policy is set for a library that
+	// was not created by the operator.
+	DeletionPolicyCreatedOnlyMessage = "deletionPolicy Delete can only be set for a library created by the operator"
+
+	// SubscriptionRequiredMessage is returned when a library of the "Subscribed" type is created without a
+	// subscription, in either spec.subscription or spec.create.subscription.
+	SubscriptionRequiredMessage = "subscription is required for a library of the Subscribed type"
+
+	// SubscriptionForbiddenMessage is returned when the subscription of a library that is not of the
+	// "Subscribed" type is set.
+	SubscriptionForbiddenMessage = "subscription can only be set for a library of the Subscribed type"
+
+	// SubscriptionConflictMessage is returned when both spec.subscription and spec.create.subscription of a
+	// library are set.
+	SubscriptionConflictMessage = "subscription and create.subscription are mutually exclusive"
+
+	// ThumbprintFormatMessage is returned when an SSL thumbprint field does not match ThumbprintPattern.
+	ThumbprintFormatMessage = "must be a SHA-1 thumbprint of the form XX:XX:...:XX"
+
+	// ImportTargetMessage is returned when neither or both of spec.target.contentLibraryRef and
+	// spec.target.clusterContentLibraryRef of a ContentLibraryItemImportRequest are set.
+	ImportTargetMessage = "exactly one of contentLibraryRef and clusterContentLibraryRef must be set"
+
+	// ImportSetManifestSourceMessage is returned when neither or both of spec.manifest.url and
+	// spec.manifest.configMapKeyRef of a ContentLibraryImportSet are set.
+	ImportSetManifestSourceMessage = "exactly one of url and configMapKeyRef must be set"
+
+	// ImageFamilyCriteriaMessage is returned when neither spec.namePattern nor spec.selector of an ImageFamily
+	// is set.
+	ImageFamilyCriteriaMessage = "at least one of namePattern and selector must be set"
+
+	// ItemRefImmutableMessage is returned when the spec.itemRef of a ContentLibraryItemValidationRequest is changed.
+	ItemRefImmutableMessage = "itemRef is immutable"
+
+	// SpecImmutableMessage is returned when the spec of a resource whose spec is immutable is changed.
+	SpecImmutableMessage = "spec is immutable"
+
+	// ContentLibraryConfigurationNameMessage is returned when a ContentLibraryConfiguration is not named "default".
+	ContentLibraryConfigurationNameMessage = "the ContentLibraryConfiguration must be named default"
+)
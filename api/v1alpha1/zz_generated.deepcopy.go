@@ -1,5 +1,4 @@
 //go:build !ignore_autogenerated
-// +build !ignore_autogenerated
 
 // Copyright (c) 2020 VMware, Inc. All Rights Reserved.
 // SPDX-License-Identifier: Apache-2.0
@@ -9,15 +8,73 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Approval) DeepCopyInto(out *Approval) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approval.
+func (in *Approval) DeepCopy() *Approval {
+	if in == nil {
+		return nil
+	}
+	out := new(Approval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttestationReference) DeepCopyInto(out *AttestationReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttestationReference.
+func (in *AttestationReference) DeepCopy() *AttestationReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AttestationReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheEvictionPolicy) DeepCopyInto(out *CacheEvictionPolicy) {
+	*out = *in
+	if in.MaxUnusedDuration != nil {
+		in, out := &in.MaxUnusedDuration, &out.MaxUnusedDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxCacheSize != nil {
+		in, out := &in.MaxCacheSize, &out.MaxCacheSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheEvictionPolicy.
+func (in *CacheEvictionPolicy) DeepCopy() *CacheEvictionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheEvictionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterContentLibrary) DeepCopyInto(out *ClusterContentLibrary) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -44,8 +101,8 @@ func (in *ClusterContentLibraryItem) DeepCopyInto(out *ClusterContentLibraryItem
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterContentLibraryItem.
@@ -101,6 +158,18 @@ func (in *ClusterContentLibraryItemList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterContentLibraryItemSpec) DeepCopyInto(out *ClusterContentLibraryItemSpec) {
 	*out = *in
+	if in.AttestationRef != nil {
+		in, out := &in.AttestationRef, &out.AttestationRef
+		*out = new(AttestationReference)
+		**out = **in
+	}
+	if in.CustomMetadata != nil {
+		in, out := &in.CustomMetadata, &out.CustomMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterContentLibraryItemSpec.
@@ -116,6 +185,107 @@ func (in *ClusterContentLibraryItemSpec) DeepCopy() *ClusterContentLibraryItemSp
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterContentLibraryItemStatus) DeepCopyInto(out *ClusterContentLibraryItemStatus) {
 	*out = *in
+	if in.PublisherInfo != nil {
+		in, out := &in.PublisherInfo, &out.PublisherInfo
+		*out = new(PublisherInfo)
+		**out = **in
+	}
+	if in.CustomMetadata != nil {
+		in, out := &in.CustomMetadata, &out.CustomMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SourceItemRef != nil {
+		in, out := &in.SourceItemRef, &out.SourceItemRef
+		*out = new(SourceItemReference)
+		**out = **in
+	}
+	if in.ImageRefs != nil {
+		in, out := &in.ImageRefs, &out.ImageRefs
+		*out = make([]NameAndKindRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.SBOMRef != nil {
+		in, out := &in.SBOMRef, &out.SBOMRef
+		*out = new(SBOMReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]FileInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeploymentDefaults != nil {
+		in, out := &in.DeploymentDefaults, &out.DeploymentDefaults
+		*out = new(DeploymentDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EULAs != nil {
+		in, out := &in.EULAs, &out.EULAs
+		*out = make([]EULA, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsoInfo != nil {
+		in, out := &in.IsoInfo, &out.IsoInfo
+		*out = new(IsoInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageURIs != nil {
+		in, out := &in.StorageURIs, &out.StorageURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncProgress != nil {
+		in, out := &in.SyncProgress, &out.SyncProgress
+		*out = new(SyncProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Signatures != nil {
+		in, out := &in.Signatures, &out.Signatures
+		*out = make([]SignatureInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUsedTime != nil {
+		in, out := &in.LastUsedTime, &out.LastUsedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDeployTime != nil {
+		in, out := &in.LastDeployTime, &out.LastDeployTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastObservedTime != nil {
+		in, out := &in.LastObservedTime, &out.LastObservedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDriftCheckTime != nil {
+		in, out := &in.LastDriftCheckTime, &out.LastDriftCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTaskInfo != nil {
+		in, out := &in.LastTaskInfo, &out.LastTaskInfo
+		*out = new(TaskInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(ErrorInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterContentLibraryItemStatus.
@@ -128,6 +298,64 @@ func (in *ClusterContentLibraryItemStatus) DeepCopy() *ClusterContentLibraryItem
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterContentLibraryItemSummary) DeepCopyInto(out *ClusterContentLibraryItemSummary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterContentLibraryItemSummary.
+func (in *ClusterContentLibraryItemSummary) DeepCopy() *ClusterContentLibraryItemSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterContentLibraryItemSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterContentLibraryItemSummary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterContentLibraryItemSummaryList) DeepCopyInto(out *ClusterContentLibraryItemSummaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterContentLibraryItemSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterContentLibraryItemSummaryList.
+func (in *ClusterContentLibraryItemSummaryList) DeepCopy() *ClusterContentLibraryItemSummaryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterContentLibraryItemSummaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterContentLibraryItemSummaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterContentLibraryList) DeepCopyInto(out *ClusterContentLibraryList) {
 	*out = *in
@@ -163,6 +391,36 @@ func (in *ClusterContentLibraryList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterContentLibrarySpec) DeepCopyInto(out *ClusterContentLibrarySpec) {
 	*out = *in
+	if in.VCenterRef != nil {
+		in, out := &in.VCenterRef, &out.VCenterRef
+		*out = new(VCenterReference)
+		**out = **in
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyncFailurePolicy != nil {
+		in, out := &in.SyncFailurePolicy, &out.SyncFailurePolicy
+		*out = new(SyncFailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransferRateLimit != nil {
+		in, out := &in.TransferRateLimit, &out.TransferRateLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ResyncIntervalSeconds != nil {
+		in, out := &in.ResyncIntervalSeconds, &out.ResyncIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterContentLibrarySpec.
@@ -189,6 +447,34 @@ func (in *ClusterContentLibraryStatus) DeepCopyInto(out *ClusterContentLibrarySt
 		*out = new(SubscriptionInfo)
 		**out = **in
 	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = new(PermissionsInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderCapabilities != nil {
+		in, out := &in.ProviderCapabilities, &out.ProviderCapabilities
+		*out = new(ProviderCapabilities)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastObservedTime != nil {
+		in, out := &in.LastObservedTime, &out.LastObservedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDriftCheckTime != nil {
+		in, out := &in.LastDriftCheckTime, &out.LastDriftCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTaskInfo != nil {
+		in, out := &in.LastTaskInfo, &out.LastTaskInfo
+		*out = new(TaskInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(ErrorInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(Conditions, len(*in))
@@ -250,7 +536,7 @@ func (in *ContentLibrary) DeepCopyInto(out *ContentLibrary) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -273,26 +559,26 @@ func (in *ContentLibrary) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentLibraryItem) DeepCopyInto(out *ContentLibraryItem) {
+func (in *ContentLibraryConfiguration) DeepCopyInto(out *ContentLibraryConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItem.
-func (in *ContentLibraryItem) DeepCopy() *ContentLibraryItem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryConfiguration.
+func (in *ContentLibraryConfiguration) DeepCopy() *ContentLibraryConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentLibraryItem)
+	out := new(ContentLibraryConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ContentLibraryItem) DeepCopyObject() runtime.Object {
+func (in *ContentLibraryConfiguration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -300,31 +586,31 @@ func (in *ContentLibraryItem) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentLibraryItemList) DeepCopyInto(out *ContentLibraryItemList) {
+func (in *ContentLibraryConfigurationList) DeepCopyInto(out *ContentLibraryConfigurationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ContentLibraryItem, len(*in))
+		*out = make([]ContentLibraryConfiguration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemList.
-func (in *ContentLibraryItemList) DeepCopy() *ContentLibraryItemList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryConfigurationList.
+func (in *ContentLibraryConfigurationList) DeepCopy() *ContentLibraryConfigurationList {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentLibraryItemList)
+	out := new(ContentLibraryConfigurationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ContentLibraryItemList) DeepCopyObject() runtime.Object {
+func (in *ContentLibraryConfigurationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -332,24 +618,55 @@ func (in *ContentLibraryItemList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentLibraryItemSpec) DeepCopyInto(out *ContentLibraryItemSpec) {
+func (in *ContentLibraryConfigurationSpec) DeepCopyInto(out *ContentLibraryConfigurationSpec) {
 	*out = *in
+	if in.DefaultSyncInterval != nil {
+		in, out := &in.DefaultSyncInterval, &out.DefaultSyncInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OrphanedItemGracePeriod != nil {
+		in, out := &in.OrphanedItemGracePeriod, &out.OrphanedItemGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StaleThreshold != nil {
+		in, out := &in.StaleThreshold, &out.StaleThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemSpec.
-func (in *ContentLibraryItemSpec) DeepCopy() *ContentLibraryItemSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryConfigurationSpec.
+func (in *ContentLibraryConfigurationSpec) DeepCopy() *ContentLibraryConfigurationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentLibraryItemSpec)
+	out := new(ContentLibraryConfigurationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentLibraryItemStatus) DeepCopyInto(out *ContentLibraryItemStatus) {
+func (in *ContentLibraryConfigurationStatus) DeepCopyInto(out *ContentLibraryConfigurationStatus) {
 	*out = *in
-	out.ContentLibraryRef = in.ContentLibraryRef
+	if in.AppliedConfiguration != nil {
+		in, out := &in.AppliedConfiguration, &out.AppliedConfiguration
+		*out = new(ContentLibraryConfigurationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(Conditions, len(*in))
@@ -359,42 +676,62 @@ func (in *ContentLibraryItemStatus) DeepCopyInto(out *ContentLibraryItemStatus)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemStatus.
-func (in *ContentLibraryItemStatus) DeepCopy() *ContentLibraryItemStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryConfigurationStatus.
+func (in *ContentLibraryConfigurationStatus) DeepCopy() *ContentLibraryConfigurationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentLibraryItemStatus)
+	out := new(ContentLibraryConfigurationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentLibraryList) DeepCopyInto(out *ContentLibraryList) {
+func (in *ContentLibraryCreateSpec) DeepCopyInto(out *ContentLibraryCreateSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ContentLibrary, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.StorageBacking != nil {
+		in, out := &in.StorageBacking, &out.StorageBacking
+		*out = new(StorageBacking)
+		**out = **in
+	}
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(SubscriptionInfo)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryList.
-func (in *ContentLibraryList) DeepCopy() *ContentLibraryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryCreateSpec.
+func (in *ContentLibraryCreateSpec) DeepCopy() *ContentLibraryCreateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentLibraryList)
+	out := new(ContentLibraryCreateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryImportSet) DeepCopyInto(out *ContentLibraryImportSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryImportSet.
+func (in *ContentLibraryImportSet) DeepCopy() *ContentLibraryImportSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryImportSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ContentLibraryList) DeepCopyObject() runtime.Object {
+func (in *ContentLibraryImportSet) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -402,48 +739,60 @@ func (in *ContentLibraryList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentLibraryReference) DeepCopyInto(out *ContentLibraryReference) {
+func (in *ContentLibraryImportSetList) DeepCopyInto(out *ContentLibraryImportSetList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibraryImportSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryReference.
-func (in *ContentLibraryReference) DeepCopy() *ContentLibraryReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryImportSetList.
+func (in *ContentLibraryImportSetList) DeepCopy() *ContentLibraryImportSetList {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentLibraryReference)
+	out := new(ContentLibraryImportSetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryImportSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentLibrarySpec) DeepCopyInto(out *ContentLibrarySpec) {
+func (in *ContentLibraryImportSetSpec) DeepCopyInto(out *ContentLibraryImportSetSpec) {
 	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibrarySpec.
-func (in *ContentLibrarySpec) DeepCopy() *ContentLibrarySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryImportSetSpec.
+func (in *ContentLibraryImportSetSpec) DeepCopy() *ContentLibraryImportSetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentLibrarySpec)
+	out := new(ContentLibraryImportSetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContentLibraryStatus) DeepCopyInto(out *ContentLibraryStatus) {
+func (in *ContentLibraryImportSetStatus) DeepCopyInto(out *ContentLibraryImportSetStatus) {
 	*out = *in
-	out.StorageBacking = in.StorageBacking
-	if in.PublishInfo != nil {
-		in, out := &in.PublishInfo, &out.PublishInfo
-		*out = new(PublishInfo)
-		**out = **in
-	}
-	if in.SubscriptionInfo != nil {
-		in, out := &in.SubscriptionInfo, &out.SubscriptionInfo
-		*out = new(SubscriptionInfo)
-		**out = **in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]ImportSetEntryStatus, len(*in))
+		copy(*out, *in)
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -454,35 +803,2322 @@ func (in *ContentLibraryStatus) DeepCopyInto(out *ContentLibraryStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryStatus.
-func (in *ContentLibraryStatus) DeepCopy() *ContentLibraryStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryImportSetStatus.
+func (in *ContentLibraryImportSetStatus) DeepCopy() *ContentLibraryImportSetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ContentLibraryStatus)
+	out := new(ContentLibraryImportSetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PublishInfo) DeepCopyInto(out *PublishInfo) {
+func (in *ContentLibraryItem) DeepCopyInto(out *ContentLibraryItem) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishInfo.
-func (in *PublishInfo) DeepCopy() *PublishInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItem.
+func (in *ContentLibraryItem) DeepCopy() *ContentLibraryItem {
 	if in == nil {
 		return nil
 	}
-	out := new(PublishInfo)
+	out := new(ContentLibraryItem)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StorageBacking) DeepCopyInto(out *StorageBacking) {
-	*out = *in
-}
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItem) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemFileUpload) DeepCopyInto(out *ContentLibraryItemFileUpload) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemFileUpload.
+func (in *ContentLibraryItemFileUpload) DeepCopy() *ContentLibraryItemFileUpload {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemFileUpload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemFileUpload) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemFileUploadList) DeepCopyInto(out *ContentLibraryItemFileUploadList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibraryItemFileUpload, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemFileUploadList.
+func (in *ContentLibraryItemFileUploadList) DeepCopy() *ContentLibraryItemFileUploadList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemFileUploadList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemFileUploadList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemFileUploadSpec) DeepCopyInto(out *ContentLibraryItemFileUploadSpec) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]FileUpload, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransferRateLimit != nil {
+		in, out := &in.TransferRateLimit, &out.TransferRateLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemFileUploadSpec.
+func (in *ContentLibraryItemFileUploadSpec) DeepCopy() *ContentLibraryItemFileUploadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemFileUploadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemFileUploadStatus) DeepCopyInto(out *ContentLibraryItemFileUploadStatus) {
+	*out = *in
+	if in.SessionExpirationTime != nil {
+		in, out := &in.SessionExpirationTime, &out.SessionExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]FileUploadStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiryTime != nil {
+		in, out := &in.ExpiryTime, &out.ExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemFileUploadStatus.
+func (in *ContentLibraryItemFileUploadStatus) DeepCopy() *ContentLibraryItemFileUploadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemFileUploadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemImportRequest) DeepCopyInto(out *ContentLibraryItemImportRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemImportRequest.
+func (in *ContentLibraryItemImportRequest) DeepCopy() *ContentLibraryItemImportRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemImportRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemImportRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemImportRequestList) DeepCopyInto(out *ContentLibraryItemImportRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibraryItemImportRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemImportRequestList.
+func (in *ContentLibraryItemImportRequestList) DeepCopy() *ContentLibraryItemImportRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemImportRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemImportRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemImportRequestSource) DeepCopyInto(out *ContentLibraryItemImportRequestSource) {
+	*out = *in
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(FileChecksum)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemImportRequestSource.
+func (in *ContentLibraryItemImportRequestSource) DeepCopy() *ContentLibraryItemImportRequestSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemImportRequestSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemImportRequestSpec) DeepCopyInto(out *ContentLibraryItemImportRequestSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	out.Target = in.Target
+	if in.TransferRateLimit != nil {
+		in, out := &in.TransferRateLimit, &out.TransferRateLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemImportRequestSpec.
+func (in *ContentLibraryItemImportRequestSpec) DeepCopy() *ContentLibraryItemImportRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemImportRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemImportRequestStatus) DeepCopyInto(out *ContentLibraryItemImportRequestStatus) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]FileUploadStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiryTime != nil {
+		in, out := &in.ExpiryTime, &out.ExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemImportRequestStatus.
+func (in *ContentLibraryItemImportRequestStatus) DeepCopy() *ContentLibraryItemImportRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemImportRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemImportRequestTarget) DeepCopyInto(out *ContentLibraryItemImportRequestTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemImportRequestTarget.
+func (in *ContentLibraryItemImportRequestTarget) DeepCopy() *ContentLibraryItemImportRequestTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemImportRequestTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemList) DeepCopyInto(out *ContentLibraryItemList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibraryItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemList.
+func (in *ContentLibraryItemList) DeepCopy() *ContentLibraryItemList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemSpec) DeepCopyInto(out *ContentLibraryItemSpec) {
+	*out = *in
+	if in.AttestationRef != nil {
+		in, out := &in.AttestationRef, &out.AttestationRef
+		*out = new(AttestationReference)
+		**out = **in
+	}
+	if in.CustomMetadata != nil {
+		in, out := &in.CustomMetadata, &out.CustomMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemSpec.
+func (in *ContentLibraryItemSpec) DeepCopy() *ContentLibraryItemSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemStatus) DeepCopyInto(out *ContentLibraryItemStatus) {
+	*out = *in
+	out.ContentLibraryRef = in.ContentLibraryRef
+	if in.PublisherInfo != nil {
+		in, out := &in.PublisherInfo, &out.PublisherInfo
+		*out = new(PublisherInfo)
+		**out = **in
+	}
+	if in.CustomMetadata != nil {
+		in, out := &in.CustomMetadata, &out.CustomMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SourceItemRef != nil {
+		in, out := &in.SourceItemRef, &out.SourceItemRef
+		*out = new(SourceItemReference)
+		**out = **in
+	}
+	if in.ImageRefs != nil {
+		in, out := &in.ImageRefs, &out.ImageRefs
+		*out = make([]NameAndKindRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.SBOMRef != nil {
+		in, out := &in.SBOMRef, &out.SBOMRef
+		*out = new(SBOMReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]FileInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeploymentDefaults != nil {
+		in, out := &in.DeploymentDefaults, &out.DeploymentDefaults
+		*out = new(DeploymentDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EULAs != nil {
+		in, out := &in.EULAs, &out.EULAs
+		*out = make([]EULA, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IsoInfo != nil {
+		in, out := &in.IsoInfo, &out.IsoInfo
+		*out = new(IsoInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageURIs != nil {
+		in, out := &in.StorageURIs, &out.StorageURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncProgress != nil {
+		in, out := &in.SyncProgress, &out.SyncProgress
+		*out = new(SyncProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Signatures != nil {
+		in, out := &in.Signatures, &out.Signatures
+		*out = make([]SignatureInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUsedTime != nil {
+		in, out := &in.LastUsedTime, &out.LastUsedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDeployTime != nil {
+		in, out := &in.LastDeployTime, &out.LastDeployTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastObservedTime != nil {
+		in, out := &in.LastObservedTime, &out.LastObservedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDriftCheckTime != nil {
+		in, out := &in.LastDriftCheckTime, &out.LastDriftCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTaskInfo != nil {
+		in, out := &in.LastTaskInfo, &out.LastTaskInfo
+		*out = new(TaskInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(ErrorInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemStatus.
+func (in *ContentLibraryItemStatus) DeepCopy() *ContentLibraryItemStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemSummary) DeepCopyInto(out *ContentLibraryItemSummary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemSummary.
+func (in *ContentLibraryItemSummary) DeepCopy() *ContentLibraryItemSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemSummary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemSummaryList) DeepCopyInto(out *ContentLibraryItemSummaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibraryItemSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemSummaryList.
+func (in *ContentLibraryItemSummaryList) DeepCopy() *ContentLibraryItemSummaryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemSummaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemSummaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemValidationRequest) DeepCopyInto(out *ContentLibraryItemValidationRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemValidationRequest.
+func (in *ContentLibraryItemValidationRequest) DeepCopy() *ContentLibraryItemValidationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemValidationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemValidationRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemValidationRequestList) DeepCopyInto(out *ContentLibraryItemValidationRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibraryItemValidationRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemValidationRequestList.
+func (in *ContentLibraryItemValidationRequestList) DeepCopy() *ContentLibraryItemValidationRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemValidationRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemValidationRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemValidationRequestSpec) DeepCopyInto(out *ContentLibraryItemValidationRequestSpec) {
+	*out = *in
+	out.ItemRef = in.ItemRef
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemValidationRequestSpec.
+func (in *ContentLibraryItemValidationRequestSpec) DeepCopy() *ContentLibraryItemValidationRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemValidationRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemValidationRequestStatus) DeepCopyInto(out *ContentLibraryItemValidationRequestStatus) {
+	*out = *in
+	if in.Findings != nil {
+		in, out := &in.Findings, &out.Findings
+		*out = make([]ValidationFinding, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnsupportedSections != nil {
+		in, out := &in.UnsupportedSections, &out.UnsupportedSections
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiryTime != nil {
+		in, out := &in.ExpiryTime, &out.ExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemValidationRequestStatus.
+func (in *ContentLibraryItemValidationRequestStatus) DeepCopy() *ContentLibraryItemValidationRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemValidationRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemVersion) DeepCopyInto(out *ContentLibraryItemVersion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemVersion.
+func (in *ContentLibraryItemVersion) DeepCopy() *ContentLibraryItemVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemVersion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemVersionList) DeepCopyInto(out *ContentLibraryItemVersionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibraryItemVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemVersionList.
+func (in *ContentLibraryItemVersionList) DeepCopy() *ContentLibraryItemVersionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemVersionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemVersionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemVersionSpec) DeepCopyInto(out *ContentLibraryItemVersionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemVersionSpec.
+func (in *ContentLibraryItemVersionSpec) DeepCopy() *ContentLibraryItemVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemVersionStatus) DeepCopyInto(out *ContentLibraryItemVersionStatus) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]FileInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CaptureTime != nil {
+		in, out := &in.CaptureTime, &out.CaptureTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]FileChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemVersionStatus.
+func (in *ContentLibraryItemVersionStatus) DeepCopy() *ContentLibraryItemVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemVolumeRequest) DeepCopyInto(out *ContentLibraryItemVolumeRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemVolumeRequest.
+func (in *ContentLibraryItemVolumeRequest) DeepCopy() *ContentLibraryItemVolumeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemVolumeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemVolumeRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemVolumeRequestList) DeepCopyInto(out *ContentLibraryItemVolumeRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibraryItemVolumeRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemVolumeRequestList.
+func (in *ContentLibraryItemVolumeRequestList) DeepCopy() *ContentLibraryItemVolumeRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemVolumeRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryItemVolumeRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemVolumeRequestSpec) DeepCopyInto(out *ContentLibraryItemVolumeRequestSpec) {
+	*out = *in
+	out.ItemRef = in.ItemRef
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeMode != nil {
+		in, out := &in.VolumeMode, &out.VolumeMode
+		*out = new(corev1.PersistentVolumeMode)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemVolumeRequestSpec.
+func (in *ContentLibraryItemVolumeRequestSpec) DeepCopy() *ContentLibraryItemVolumeRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemVolumeRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryItemVolumeRequestStatus) DeepCopyInto(out *ContentLibraryItemVolumeRequestStatus) {
+	*out = *in
+	if in.ClaimRef != nil {
+		in, out := &in.ClaimRef, &out.ClaimRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(SyncProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiryTime != nil {
+		in, out := &in.ExpiryTime, &out.ExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryItemVolumeRequestStatus.
+func (in *ContentLibraryItemVolumeRequestStatus) DeepCopy() *ContentLibraryItemVolumeRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryItemVolumeRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryList) DeepCopyInto(out *ContentLibraryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibrary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryList.
+func (in *ContentLibraryList) DeepCopy() *ContentLibraryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryReference) DeepCopyInto(out *ContentLibraryReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryReference.
+func (in *ContentLibraryReference) DeepCopy() *ContentLibraryReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryReplication) DeepCopyInto(out *ContentLibraryReplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryReplication.
+func (in *ContentLibraryReplication) DeepCopy() *ContentLibraryReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryReplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryReplicationList) DeepCopyInto(out *ContentLibraryReplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibraryReplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryReplicationList.
+func (in *ContentLibraryReplicationList) DeepCopy() *ContentLibraryReplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryReplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibraryReplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryReplicationSpec) DeepCopyInto(out *ContentLibraryReplicationSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryReplicationSpec.
+func (in *ContentLibraryReplicationSpec) DeepCopy() *ContentLibraryReplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryReplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryReplicationStatus) DeepCopyInto(out *ContentLibraryReplicationStatus) {
+	*out = *in
+	if in.LastReplicationTime != nil {
+		in, out := &in.LastReplicationTime, &out.LastReplicationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ItemReplicationStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryReplicationStatus.
+func (in *ContentLibraryReplicationStatus) DeepCopy() *ContentLibraryReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibrarySpec) DeepCopyInto(out *ContentLibrarySpec) {
+	*out = *in
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(ContentLibraryCreateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VCenterRef != nil {
+		in, out := &in.VCenterRef, &out.VCenterRef
+		*out = new(VCenterReference)
+		**out = **in
+	}
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(SubscriptionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Publish != nil {
+		in, out := &in.Publish, &out.Publish
+		*out = new(PublishSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ItemMetadataTemplate != nil {
+		in, out := &in.ItemMetadataTemplate, &out.ItemMetadataTemplate
+		*out = new(ItemMetadataTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyncFailurePolicy != nil {
+		in, out := &in.SyncFailurePolicy, &out.SyncFailurePolicy
+		*out = new(SyncFailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransferRateLimit != nil {
+		in, out := &in.TransferRateLimit, &out.TransferRateLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ResyncIntervalSeconds != nil {
+		in, out := &in.ResyncIntervalSeconds, &out.ResyncIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CacheEvictionPolicy != nil {
+		in, out := &in.CacheEvictionPolicy, &out.CacheEvictionPolicy
+		*out = new(CacheEvictionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibrarySpec.
+func (in *ContentLibrarySpec) DeepCopy() *ContentLibrarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibrarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibraryStatus) DeepCopyInto(out *ContentLibraryStatus) {
+	*out = *in
+	out.StorageBacking = in.StorageBacking
+	if in.PublishInfo != nil {
+		in, out := &in.PublishInfo, &out.PublishInfo
+		*out = new(PublishInfo)
+		**out = **in
+	}
+	if in.SubscriptionInfo != nil {
+		in, out := &in.SubscriptionInfo, &out.SubscriptionInfo
+		*out = new(SubscriptionInfo)
+		**out = **in
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = new(PermissionsInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderCapabilities != nil {
+		in, out := &in.ProviderCapabilities, &out.ProviderCapabilities
+		*out = new(ProviderCapabilities)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastObservedTime != nil {
+		in, out := &in.LastObservedTime, &out.LastObservedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDriftCheckTime != nil {
+		in, out := &in.LastDriftCheckTime, &out.LastDriftCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTaskInfo != nil {
+		in, out := &in.LastTaskInfo, &out.LastTaskInfo
+		*out = new(TaskInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(ErrorInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibraryStatus.
+func (in *ContentLibraryStatus) DeepCopy() *ContentLibraryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibraryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibrarySyncRequest) DeepCopyInto(out *ContentLibrarySyncRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibrarySyncRequest.
+func (in *ContentLibrarySyncRequest) DeepCopy() *ContentLibrarySyncRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibrarySyncRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibrarySyncRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibrarySyncRequestList) DeepCopyInto(out *ContentLibrarySyncRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ContentLibrarySyncRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibrarySyncRequestList.
+func (in *ContentLibrarySyncRequestList) DeepCopy() *ContentLibrarySyncRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibrarySyncRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContentLibrarySyncRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibrarySyncRequestSpec) DeepCopyInto(out *ContentLibrarySyncRequestSpec) {
+	*out = *in
+	if in.ItemRefs != nil {
+		in, out := &in.ItemRefs, &out.ItemRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibrarySyncRequestSpec.
+func (in *ContentLibrarySyncRequestSpec) DeepCopy() *ContentLibrarySyncRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibrarySyncRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentLibrarySyncRequestStatus) DeepCopyInto(out *ContentLibrarySyncRequestStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiryTime != nil {
+		in, out := &in.ExpiryTime, &out.ExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentLibrarySyncRequestStatus.
+func (in *ContentLibrarySyncRequestStatus) DeepCopy() *ContentLibrarySyncRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentLibrarySyncRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentDefaults) DeepCopyInto(out *DeploymentDefaults) {
+	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeploymentOptions != nil {
+		in, out := &in.DeploymentOptions, &out.DeploymentOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinMemory != nil {
+		in, out := &in.MinMemory, &out.MinMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentDefaults.
+func (in *DeploymentDefaults) DeepCopy() *DeploymentDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Deprecation) DeepCopyInto(out *Deprecation) {
+	*out = *in
+	out.GroupVersionKind = in.GroupVersionKind
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deprecation.
+func (in *Deprecation) DeepCopy() *Deprecation {
+	if in == nil {
+		return nil
+	}
+	out := new(Deprecation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EULA) DeepCopyInto(out *EULA) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EULA.
+func (in *EULA) DeepCopy() *EULA {
+	if in == nil {
+		return nil
+	}
+	out := new(EULA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorInfo) DeepCopyInto(out *ErrorInfo) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorInfo.
+func (in *ErrorInfo) DeepCopy() *ErrorInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in FeatureGates) DeepCopyInto(out *FeatureGates) {
+	{
+		in := &in
+		*out = make(FeatureGates, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureGates.
+func (in FeatureGates) DeepCopy() FeatureGates {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureGates)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileChange) DeepCopyInto(out *FileChange) {
+	*out = *in
+	if in.OldChecksum != nil {
+		in, out := &in.OldChecksum, &out.OldChecksum
+		*out = new(FileChecksum)
+		**out = **in
+	}
+	if in.NewChecksum != nil {
+		in, out := &in.NewChecksum, &out.NewChecksum
+		*out = new(FileChecksum)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileChange.
+func (in *FileChange) DeepCopy() *FileChange {
+	if in == nil {
+		return nil
+	}
+	out := new(FileChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileChecksum) DeepCopyInto(out *FileChecksum) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileChecksum.
+func (in *FileChecksum) DeepCopy() *FileChecksum {
+	if in == nil {
+		return nil
+	}
+	out := new(FileChecksum)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileInfo) DeepCopyInto(out *FileInfo) {
+	*out = *in
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(FileChecksum)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileInfo.
+func (in *FileInfo) DeepCopy() *FileInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FileInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileUpload) DeepCopyInto(out *FileUpload) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(FileChecksum)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileUpload.
+func (in *FileUpload) DeepCopy() *FileUpload {
+	if in == nil {
+		return nil
+	}
+	out := new(FileUpload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileUploadSource) DeepCopyInto(out *FileUploadSource) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPFileSource)
+		**out = **in
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(PersistentVolumeClaimFileSource)
+		**out = **in
+	}
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileUploadSource.
+func (in *FileUploadSource) DeepCopy() *FileUploadSource {
+	if in == nil {
+		return nil
+	}
+	out := new(FileUploadSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileUploadStatus) DeepCopyInto(out *FileUploadStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileUploadStatus.
+func (in *FileUploadStatus) DeepCopy() *FileUploadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FileUploadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPFileSource) DeepCopyInto(out *HTTPFileSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPFileSource.
+func (in *HTTPFileSource) DeepCopy() *HTTPFileSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPFileSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAlias) DeepCopyInto(out *ImageAlias) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAlias.
+func (in *ImageAlias) DeepCopy() *ImageAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageAlias) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAliasList) DeepCopyInto(out *ImageAliasList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAliasList.
+func (in *ImageAliasList) DeepCopy() *ImageAliasList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAliasList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageAliasList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAliasSpec) DeepCopyInto(out *ImageAliasSpec) {
+	*out = *in
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAliasSpec.
+func (in *ImageAliasSpec) DeepCopy() *ImageAliasSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAliasSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAliasStatus) DeepCopyInto(out *ImageAliasStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAliasStatus.
+func (in *ImageAliasStatus) DeepCopy() *ImageAliasStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAliasStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAliasTarget) DeepCopyInto(out *ImageAliasTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAliasTarget.
+func (in *ImageAliasTarget) DeepCopy() *ImageAliasTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAliasTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFamily) DeepCopyInto(out *ImageFamily) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFamily.
+func (in *ImageFamily) DeepCopy() *ImageFamily {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFamily)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageFamily) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFamilyList) DeepCopyInto(out *ImageFamilyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageFamily, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFamilyList.
+func (in *ImageFamilyList) DeepCopy() *ImageFamilyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFamilyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageFamilyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFamilySpec) DeepCopyInto(out *ImageFamilySpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFamilySpec.
+func (in *ImageFamilySpec) DeepCopy() *ImageFamilySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFamilySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFamilyStatus) DeepCopyInto(out *ImageFamilyStatus) {
+	*out = *in
+	if in.Latest != nil {
+		in, out := &in.Latest, &out.Latest
+		*out = new(LibraryItemReference)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFamilyStatus.
+func (in *ImageFamilyStatus) DeepCopy() *ImageFamilyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFamilyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportSetEntry) DeepCopyInto(out *ImportSetEntry) {
+	*out = *in
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(FileChecksum)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportSetEntry.
+func (in *ImportSetEntry) DeepCopy() *ImportSetEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportSetEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportSetEntryStatus) DeepCopyInto(out *ImportSetEntryStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportSetEntryStatus.
+func (in *ImportSetEntryStatus) DeepCopy() *ImportSetEntryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportSetEntryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportSetManifestSource) DeepCopyInto(out *ImportSetManifestSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportSetManifestSource.
+func (in *ImportSetManifestSource) DeepCopy() *ImportSetManifestSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportSetManifestSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsoInfo) DeepCopyInto(out *IsoInfo) {
+	*out = *in
+	if in.OSHints != nil {
+		in, out := &in.OSHints, &out.OSHints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsoInfo.
+func (in *IsoInfo) DeepCopy() *IsoInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(IsoInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ItemDigest) DeepCopyInto(out *ItemDigest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemDigest.
+func (in *ItemDigest) DeepCopy() *ItemDigest {
+	if in == nil {
+		return nil
+	}
+	out := new(ItemDigest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ItemFileReference) DeepCopyInto(out *ItemFileReference) {
+	*out = *in
+	out.ItemRef = in.ItemRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemFileReference.
+func (in *ItemFileReference) DeepCopy() *ItemFileReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ItemFileReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ItemMetadataTemplate) DeepCopyInto(out *ItemMetadataTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemMetadataTemplate.
+func (in *ItemMetadataTemplate) DeepCopy() *ItemMetadataTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ItemMetadataTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ItemMetadataVariables) DeepCopyInto(out *ItemMetadataVariables) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemMetadataVariables.
+func (in *ItemMetadataVariables) DeepCopy() *ItemMetadataVariables {
+	if in == nil {
+		return nil
+	}
+	out := new(ItemMetadataVariables)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ItemReplicationStatus) DeepCopyInto(out *ItemReplicationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemReplicationStatus.
+func (in *ItemReplicationStatus) DeepCopy() *ItemReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ItemReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ItemSummaryStatus) DeepCopyInto(out *ItemSummaryStatus) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ItemDigest, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemSummaryStatus.
+func (in *ItemSummaryStatus) DeepCopy() *ItemSummaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ItemSummaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LibraryItemReference) DeepCopyInto(out *LibraryItemReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LibraryItemReference.
+func (in *LibraryItemReference) DeepCopy() *LibraryItemReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LibraryItemReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LibraryNotification) DeepCopyInto(out *LibraryNotification) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LibraryNotification.
+func (in *LibraryNotification) DeepCopy() *LibraryNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(LibraryNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NameAndKindRef) DeepCopyInto(out *NameAndKindRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NameAndKindRef.
+func (in *NameAndKindRef) DeepCopy() *NameAndKindRef {
+	if in == nil {
+		return nil
+	}
+	out := new(NameAndKindRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPolicy) DeepCopyInto(out *NotificationPolicy) {
+	*out = *in
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]NotificationType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPolicy.
+func (in *NotificationPolicy) DeepCopy() *NotificationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Permission) DeepCopyInto(out *Permission) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permission.
+func (in *Permission) DeepCopy() *Permission {
+	if in == nil {
+		return nil
+	}
+	out := new(Permission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionsInfo) DeepCopyInto(out *PermissionsInfo) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]Permission, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionsInfo.
+func (in *PermissionsInfo) DeepCopy() *PermissionsInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionsInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimFileSource) DeepCopyInto(out *PersistentVolumeClaimFileSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimFileSource.
+func (in *PersistentVolumeClaimFileSource) DeepCopy() *PersistentVolumeClaimFileSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimFileSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderCapabilities) DeepCopyInto(out *ProviderCapabilities) {
+	*out = *in
+	if in.MaxItemSize != nil {
+		in, out := &in.MaxItemSize, &out.MaxItemSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderCapabilities.
+func (in *ProviderCapabilities) DeepCopy() *ProviderCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyConfiguration) DeepCopyInto(out *ProxyConfiguration) {
+	*out = *in
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyConfiguration.
+func (in *ProxyConfiguration) DeepCopy() *ProxyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishAuthentication) DeepCopyInto(out *PublishAuthentication) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishAuthentication.
+func (in *PublishAuthentication) DeepCopy() *PublishAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(PublishAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishInfo) DeepCopyInto(out *PublishInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishInfo.
+func (in *PublishInfo) DeepCopy() *PublishInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PublishInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishSpec) DeepCopyInto(out *PublishSpec) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(PublishAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishSpec.
+func (in *PublishSpec) DeepCopy() *PublishSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublishSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublisherInfo) DeepCopyInto(out *PublisherInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublisherInfo.
+func (in *PublisherInfo) DeepCopy() *PublisherInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PublisherInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSource) DeepCopyInto(out *ReplicationSource) {
+	*out = *in
+	if in.ItemSelector != nil {
+		in, out := &in.ItemSelector, &out.ItemSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSource.
+func (in *ReplicationSource) DeepCopy() *ReplicationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SBOMReference) DeepCopyInto(out *SBOMReference) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SBOMReference.
+func (in *SBOMReference) DeepCopy() *SBOMReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SBOMReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignatureInfo) DeepCopyInto(out *SignatureInfo) {
+	*out = *in
+	if in.SignedTime != nil {
+		in, out := &in.SignedTime, &out.SignedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignatureInfo.
+func (in *SignatureInfo) DeepCopy() *SignatureInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(SignatureInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceItemReference) DeepCopyInto(out *SourceItemReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceItemReference.
+func (in *SourceItemReference) DeepCopy() *SourceItemReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceItemReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageBacking) DeepCopyInto(out *StorageBacking) {
+	*out = *in
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBacking.
 func (in *StorageBacking) DeepCopy() *StorageBacking {
@@ -508,3 +3144,119 @@ func (in *SubscriptionInfo) DeepCopy() *SubscriptionInfo {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionSpec) DeepCopyInto(out *SubscriptionSpec) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionSpec.
+func (in *SubscriptionSpec) DeepCopy() *SubscriptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncFailurePolicy) DeepCopyInto(out *SyncFailurePolicy) {
+	*out = *in
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncFailurePolicy.
+func (in *SyncFailurePolicy) DeepCopy() *SyncFailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncFailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncProgress) DeepCopyInto(out *SyncProgress) {
+	*out = *in
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncProgress.
+func (in *SyncProgress) DeepCopy() *SyncProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskInfo) DeepCopyInto(out *TaskInfo) {
+	*out = *in
+	if in.QueueTime != nil {
+		in, out := &in.QueueTime, &out.QueueTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskInfo.
+func (in *TaskInfo) DeepCopy() *TaskInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VCenterReference) DeepCopyInto(out *VCenterReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VCenterReference.
+func (in *VCenterReference) DeepCopy() *VCenterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VCenterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationFinding) DeepCopyInto(out *ValidationFinding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationFinding.
+func (in *ValidationFinding) DeepCopy() *ValidationFinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationFinding)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,5266 @@
+// Code generated by gen-models. DO NOT EDIT.
+
+/** ObjectMeta is the standard Kubernetes object metadata. */
+export interface ObjectMeta {
+  name?: string;
+  namespace?: string;
+  labels?: { [key: string]: string };
+  annotations?: { [key: string]: string };
+  [key: string]: unknown;
+}
+
+/**
+ * A label selector requirement is a selector that contains values, a key, and an operator that
+ * relates the key and values.
+ */
+export interface ClusterContentLibrarySpecAllowedNamespacesMatchExpressions {
+  /**
+   * key is the label key that the selector applies to.
+   */
+  key: string;
+  /**
+   * operator represents a key's relationship to a set of values.
+   * Valid operators are In, NotIn, Exists and DoesNotExist.
+   */
+  operator: string;
+  /**
+   * values is an array of string values. If the operator is In or NotIn,
+   * the values array must be non-empty. If the operator is Exists or DoesNotExist,
+   * the values array must be empty. This array is replaced during a strategic
+   * merge patch.
+   */
+  values?: string[];
+}
+
+/**
+ * AllowedNamespaces selects the namespaces from which library items can be imported into this library,
+ * e.g. with a ContentLibraryItemImportRequest. If unset, library items cannot be imported from any
+ * namespace, and an empty selector allows every namespace.
+ * This field applies only if the library is writable.
+ */
+export interface ClusterContentLibrarySpecAllowedNamespaces {
+  /**
+   * matchExpressions is a list of label selector requirements. The requirements are ANDed.
+   */
+  matchExpressions?: ClusterContentLibrarySpecAllowedNamespacesMatchExpressions[];
+  /**
+   * matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+   * map is equivalent to an element of matchExpressions, whose key field is "key", the
+   * operator is "In", and the values array contains only "value". The requirements are ANDed.
+   */
+  matchLabels?: { [key: string]: string };
+}
+
+/**
+ * CredentialsSecretRef refers to a Secret containing the "username" and "password" keys used to
+ * authenticate against the proxy. If the namespace is omitted, the namespace of the library is assumed.
+ */
+export interface ClusterContentLibrarySpecProxyCredentialsSecretRef {
+  /**
+   * Name is unique within a namespace to reference a secret resource.
+   */
+  name?: string;
+  /**
+   * Namespace defines the space within which the secret name must be unique.
+   */
+  namespace?: string;
+}
+
+/**
+ * Proxy specifies the proxy used to reach the subscription URL of the library. If unset, the vCenter-wide
+ * proxy settings apply. This field applies only if the library is of the "Subscribed" type.
+ * The namespace of Proxy.CredentialsSecretRef must be set for cluster scoped libraries.
+ */
+export interface ClusterContentLibrarySpecProxy {
+  /**
+   * CredentialsSecretRef refers to a Secret containing the "username" and "password" keys used to
+   * authenticate against the proxy. If the namespace is omitted, the namespace of the library is assumed.
+   */
+  credentialsSecretRef?: ClusterContentLibrarySpecProxyCredentialsSecretRef;
+  /**
+   * HTTPProxy is the URL of the proxy server used for HTTP requests, e.g. "http://proxy.example.com:3128".
+   */
+  httpProxy?: string;
+  /**
+   * HTTPSProxy is the URL of the proxy server used for HTTPS requests.
+   */
+  httpsProxy?: string;
+  /**
+   * NoProxy is a list of host names, domain suffixes, IP addresses or CIDRs that must be reached directly
+   * instead of through the proxy.
+   */
+  noProxy?: string[];
+}
+
+/**
+ * SyncFailurePolicy describes how the failed synchronizations of the library are retried. If unset, the
+ * defaults of the content library operator apply.
+ * This field applies only if the library is of the "Subscribed" type.
+ */
+export interface ClusterContentLibrarySpecSyncFailurePolicy {
+  /**
+   * MaxBackoff is the maximum delay between two retries of a failed synchronization. The delay grows
+   * exponentially with the number of consecutive failures up to MaxBackoff.
+   */
+  maxBackoff?: string;
+  /**
+   * MaxConsecutiveFailures is the number of synchronizations that can fail in a row before the library is
+   * marked Degraded. Synchronizations are still retried once the library is degraded, with the maximum
+   * backoff.
+   */
+  maxConsecutiveFailures?: number;
+}
+
+/**
+ * VCenterRef refers to the vCenter the library belongs to. If unset, the default vCenter of the
+ * supervisor is assumed. This field is immutable.
+ */
+export interface ClusterContentLibrarySpecVCenterRef {
+  /**
+   * Name is the name of the vCenter connection, e.g. a Secret holding the vCenter endpoint and credentials,
+   * in the namespace of the content library operator.
+   */
+  name: string;
+}
+
+/**
+ * ClusterContentLibrarySpec defines the desired state of a ClusterContentLibrary.
+ */
+export interface ClusterContentLibrarySpec {
+  /**
+   * AllowedNamespaces selects the namespaces from which library items can be imported into this library,
+   * e.g. with a ContentLibraryItemImportRequest. If unset, library items cannot be imported from any
+   * namespace, and an empty selector allows every namespace.
+   * This field applies only if the library is writable.
+   */
+  allowedNamespaces?: ClusterContentLibrarySpecAllowedNamespaces;
+  /**
+   * Proxy specifies the proxy used to reach the subscription URL of the library. If unset, the vCenter-wide
+   * proxy settings apply. This field applies only if the library is of the "Subscribed" type.
+   * The namespace of Proxy.CredentialsSecretRef must be set for cluster scoped libraries.
+   */
+  proxy?: ClusterContentLibrarySpecProxy;
+  /**
+   * ResyncIntervalSeconds is the interval, in seconds, at which the library is reconciled with vCenter. It
+   * overrides the defaultSyncInterval of the ContentLibraryConfiguration for this library, e.g. to re-list
+   * large static libraries less often than rapidly changing ones. If unset, the default interval applies.
+   */
+  resyncIntervalSeconds?: number;
+  /**
+   * SyncFailurePolicy describes how the failed synchronizations of the library are retried. If unset, the
+   * defaults of the content library operator apply.
+   * This field applies only if the library is of the "Subscribed" type.
+   */
+  syncFailurePolicy?: ClusterContentLibrarySpecSyncFailurePolicy;
+  /**
+   * TransferRateLimit is the maximum rate, in bytes per second, at which the content of the library items
+   * is transferred when the library is synchronized, e.g. "100Mi". If unset, transfers are not limited.
+   */
+  transferRateLimit?: number | string;
+  /**
+   * UUID is the identifier which uniquely identifies the library in vCenter. This field is immutable.
+   */
+  uuid: string;
+  /**
+   * VCenterRef refers to the vCenter the library belongs to. If unset, the default vCenter of the
+   * supervisor is assumed. This field is immutable.
+   */
+  vCenterRef?: ClusterContentLibrarySpecVCenterRef;
+  /**
+   * Writable flag indicates if new library items can be created in this library, and the existing library
+   * items modified, from Kubernetes. Only a library of the "Local" type can be writable.
+   */
+  writable?: boolean;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ClusterContentLibraryStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * LastError describes the last error encountered while reconciling the library, and whether it is retried.
+ * This field is cleared once the library is reconciled successfully.
+ */
+export interface ClusterContentLibraryStatusLastError {
+  /**
+   * Message is a human-readable description of the error.
+   */
+  message: string;
+  /**
+   * NextRetryTime indicates when the controller retries the operation next.
+   * This field is populated only if Retryable is true.
+   */
+  nextRetryTime?: string;
+  /**
+   * Operation is the operation that failed, e.g. "Sync" or "Import".
+   */
+  operation: string;
+  /**
+   * RetryCount is the number of times the operation was retried since it first failed.
+   */
+  retryCount?: number;
+  /**
+   * Retryable indicates whether the controller retries the operation automatically. If false, the error
+   * requires intervention.
+   */
+  retryable: boolean;
+  /**
+   * Time indicates when the error occurred.
+   */
+  time: string;
+}
+
+/**
+ * LastTaskInfo describes the last vCenter task that failed for this library.
+ */
+export interface ClusterContentLibraryStatusLastTaskInfo {
+  /**
+   * CompletionTime indicates the time when the task completed in vCenter.
+   */
+  completionTime?: string;
+  /**
+   * Description is a human-readable description of the task.
+   */
+  description?: string;
+  /**
+   * ErrorMessage is the error reported by vCenter for the task.
+   */
+  errorMessage?: string;
+  /**
+   * ID is the managed object reference of the vCenter task, e.g. "task-1234".
+   */
+  id: string;
+  /**
+   * OperationID is the vCenter operation ID (opID) of the request that started the task.
+   */
+  operationID?: string;
+  /**
+   * QueueTime indicates the time when the task was queued in vCenter.
+   */
+  queueTime?: string;
+  /**
+   * StartTime indicates the time when the task was started in vCenter.
+   */
+  startTime?: string;
+}
+
+/**
+ * Permission describes a vCenter principal that holds a role granting privileges to modify a library,
+ * either on the library itself or inherited from a parent object in vCenter.
+ */
+export interface ClusterContentLibraryStatusPermissionsEntries {
+  /**
+   * Group indicates whether the principal is a group.
+   */
+  group?: boolean;
+  /**
+   * Inherited indicates whether the permission is inherited from a parent object of the library in vCenter.
+   */
+  inherited?: boolean;
+  /**
+   * Principal is the name of the vCenter user or group, e.g. "Administrators@vsphere.local".
+   */
+  principal: string;
+  /**
+   * Role is the name of the vCenter role held by the principal.
+   */
+  role: string;
+}
+
+/**
+ * Permissions summarizes the vCenter principals that can modify the library, so that they can be audited
+ * from Kubernetes. It is refreshed periodically.
+ */
+export interface ClusterContentLibraryStatusPermissions {
+  /**
+   * Entries are the vCenter principals that can modify the library, sorted by principal.
+   */
+  entries?: ClusterContentLibraryStatusPermissionsEntries[];
+  /**
+   * LastRefreshTime indicates the time when the permissions were last read from vCenter.
+   */
+  lastRefreshTime?: string;
+}
+
+/**
+ * ProviderCapabilities describes the content library features supported by the vCenter the library
+ * belongs to. It is refreshed when the version of the vCenter changes.
+ */
+export interface ClusterContentLibraryStatusProviderCapabilities {
+  /**
+   * MaxItemSize is the maximum size of the content of a library item supported by the vCenter. If unset,
+   * the size is not limited.
+   */
+  maxItemSize?: number | string;
+  /**
+   * SupportsOVFMultiSite indicates whether the OVF templates of the library can be synchronized to
+   * subscribed libraries of other vCenters, e.g. of another site.
+   */
+  supportsOVFMultiSite?: boolean;
+  /**
+   * SupportsSecurityPolicies indicates whether security policies, such as OVF signature verification, can
+   * be applied to the library.
+   */
+  supportsSecurityPolicies?: boolean;
+  /**
+   * VCenterBuild is the build number of the vCenter the capabilities were derived from, e.g. "21560480".
+   */
+  vCenterBuild?: string;
+  /**
+   * VCenterVersion is the version of the vCenter the capabilities were derived from, e.g. "8.0.1".
+   */
+  vCenterVersion?: string;
+}
+
+/**
+ * Published indicates how the library is published so that it can be subscribed to by a remote subscribed library.
+ */
+export interface ClusterContentLibraryStatusPublishInfo {
+  /**
+   * PersistJSONEnabled reports whether vCenter persists the library and library item metadata of the library
+   * as JSON files on its storage backing, as observed in the publish settings of the library in vCenter. It
+   * may differ from Spec.Publish.PersistJSONEnabled until the operator applied the spec.
+   */
+  persistJSONEnabled?: boolean;
+  /**
+   * PublishURL is the URL to which the library metadata is published by the vSphere Content Library Service.
+   * This value can be used to set the SubscriptionInfo.subscriptionURL property when creating a subscribed library.
+   */
+  publishURL: string;
+  /**
+   * Published indicates if the local library is published.
+   */
+  published: boolean;
+  /**
+   * SubscriptionCount is the number of subscribed libraries, in this vCenter or remote ones, that subscribe
+   * to the published library.
+   */
+  subscriptionCount?: number;
+}
+
+/**
+ * StorageBacking indicates the default storage backing available for this library in vCenter.
+ */
+export interface ClusterContentLibraryStatusStorageBacking {
+  /**
+   * DatastoreID indicates the identifier of the datastore used to store the content
+   * in the library for the "Datastore" storageType in vCenter.
+   */
+  datastoreID?: string;
+  /**
+   * DatastoreName indicates the name of the datastore identified by DatastoreID.
+   */
+  datastoreName?: string;
+  /**
+   * StoragePodID indicates the identifier of the datastore cluster used to store the content in the library
+   * for the "DatastoreCluster" storageType in vCenter, e.g. "group-p123". DatastoreID then indicates the
+   * datastore of the cluster selected by Storage DRS, if known.
+   */
+  storagePodID?: string;
+  /**
+   * StoragePodName indicates the name of the datastore cluster identified by StoragePodID.
+   */
+  storagePodName?: string;
+  /**
+   * Type indicates the type of storage where the content would be stored.
+   * Possible values are "Datastore", "DatastoreCluster" and "Other".
+   */
+  type: string;
+}
+
+/**
+ * SubscriptionInfo defines how the subscribed library synchronizes to a remote source.
+ * This field is populated only if the library is of the "Subscribed" type.
+ */
+export interface ClusterContentLibraryStatusSubscriptionInfo {
+  /**
+   * AutomaticSyncEnabled indicates whether the library should participate in automatic library synchronization.
+   */
+  automaticSyncEnabled: boolean;
+  /**
+   * OnDemand indicates whether a library item’s content will be synchronized only on demand.
+   */
+  onDemand: boolean;
+  /**
+   * SubscriptionURL is the URL of the endpoint where the metadata for the remotely published library is being served.
+   * The value from PublishInfo.PublishURL of the published library should be used while creating a subscribed library.
+   */
+  subscriptionURL: string;
+}
+
+/**
+ * ClusterContentLibraryStatus defines the observed state of ClusterContentLibrary.
+ */
+export interface ClusterContentLibraryStatus {
+  /**
+   * Conditions describes the current condition information of the ClusterContentLibrary.
+   */
+  conditions?: ClusterContentLibraryStatusConditions[];
+  /**
+   * ConsecutiveFailures is the number of synchronizations of the library that failed in a row. It is reset
+   * when a synchronization succeeds.
+   */
+  consecutiveFailures?: number;
+  /**
+   * CreationTime indicates the date and time when this library was created.
+   */
+  creationTime: string;
+  /**
+   * Description is a human-readable description for this library.
+   */
+  description?: string;
+  /**
+   * LastDriftCheckTime indicates the time when the library was last compared with vCenter to detect
+   * out-of-band changes.
+   */
+  lastDriftCheckTime?: string;
+  /**
+   * LastError describes the last error encountered while reconciling the library, and whether it is retried.
+   * This field is cleared once the library is reconciled successfully.
+   */
+  lastError?: ClusterContentLibraryStatusLastError;
+  /**
+   * LastModifiedTime indicates the date and time when this library was last updated.
+   * This field is updated only when the library properties are changed. This field is not updated when a library
+   * item is added, modified or deleted or its content is changed.
+   */
+  lastModifiedTime: string;
+  /**
+   * LastObservedTime indicates the time when the controller last reconciled the library. It is updated on
+   * every reconciliation, even when nothing changed, so that a wedged controller can be detected.
+   */
+  lastObservedTime?: string;
+  /**
+   * LastSyncTime indicates the date and time when this library was last synchronized.
+   * This field applies only if the library is of the "Subscribed" Type.
+   */
+  lastSyncTime?: string;
+  /**
+   * LastTaskInfo describes the last vCenter task that failed for this library.
+   */
+  lastTaskInfo?: ClusterContentLibraryStatusLastTaskInfo;
+  /**
+   * Name specifies the name of the content library in vCenter.
+   */
+  name: string;
+  /**
+   * Permissions summarizes the vCenter principals that can modify the library, so that they can be audited
+   * from Kubernetes. It is refreshed periodically.
+   */
+  permissions?: ClusterContentLibraryStatusPermissions;
+  /**
+   * ProviderCapabilities describes the content library features supported by the vCenter the library
+   * belongs to. It is refreshed when the version of the vCenter changes.
+   */
+  providerCapabilities?: ClusterContentLibraryStatusProviderCapabilities;
+  /**
+   * Published indicates how the library is published so that it can be subscribed to by a remote subscribed library.
+   */
+  publishInfo?: ClusterContentLibraryStatusPublishInfo;
+  /**
+   * ResyncIntervalSeconds is the effective interval, in seconds, at which the library is reconciled with
+   * vCenter, i.e. spec.resyncIntervalSeconds if set, or the default interval of the operator otherwise.
+   */
+  resyncIntervalSeconds?: number;
+  /**
+   * StorageBacking indicates the default storage backing available for this library in vCenter.
+   */
+  storageBacking: ClusterContentLibraryStatusStorageBacking;
+  /**
+   * SubscriptionInfo defines how the subscribed library synchronizes to a remote source.
+   * This field is populated only if the library is of the "Subscribed" type.
+   */
+  subscriptionInfo?: ClusterContentLibraryStatusSubscriptionInfo;
+  /**
+   * Summary is a one-line human readable summary of the state of the ClusterContentLibrary, e.g. "Ready" or
+   * "Error: publisher certificate expired", derived from its conditions by conditions.ReadyMessage.
+   */
+  summary?: string;
+  /**
+   * Type indicates the type of a library in vCenter.
+   * Possible types are "Local" and "Subscribed".
+   */
+  type: string;
+  /**
+   * VCenterInstanceUUID is the instance UUID of the vCenter the library belongs to.
+   */
+  vCenterInstanceUUID?: string;
+  /**
+   * Version is a number that can identify metadata changes. This integer value is incremented when the library
+   * properties such as name or description are changed in vCenter.
+   */
+  version: string;
+}
+
+/**
+ * ClusterContentLibrary is the schema for the cluster scoped content library API.
+ * The library items of a ClusterContentLibrary can only be created or modified from Kubernetes if the library
+ * is writable.
+ */
+export interface ClusterContentLibrary {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ClusterContentLibrarySpec defines the desired state of a ClusterContentLibrary.
+   */
+  spec?: ClusterContentLibrarySpec;
+  /**
+   * ClusterContentLibraryStatus defines the observed state of ClusterContentLibrary.
+   */
+  status?: ClusterContentLibraryStatus;
+}
+
+/**
+ * AttestationRef refers to the signatures or attestations of the content of the library item. When set,
+ * the content is verified against them and the result is reported by the SignatureVerified condition.
+ */
+export interface ClusterContentLibraryItemSpecAttestationRef {
+  /**
+   * Reference is the location of the signatures, e.g. the OCI reference of a cosign signature
+   * "registry.example.com/images/photon:sha256-<digest>.sig".
+   */
+  reference: string;
+  /**
+   * Type is the format of the signatures.
+   * Possible values are "Cosign" and "Notary".
+   */
+  type: "Cosign" | "Notary";
+}
+
+/**
+ * ClusterContentLibraryItemSpec defines the desired state of a ClusterContentLibraryItem.
+ */
+export interface ClusterContentLibraryItemSpec {
+  /**
+   * AttestationRef refers to the signatures or attestations of the content of the library item. When set,
+   * the content is verified against them and the result is reported by the SignatureVerified condition.
+   */
+  attestationRef?: ClusterContentLibraryItemSpecAttestationRef;
+  /**
+   * CustomMetadata is the desired custom metadata of the library item in vCenter. When set, the metadata of
+   * the item in vCenter is updated to match.
+   * The keys and values must not exceed CustomMetadataMaxBytes in total.
+   * This field can only be set for items of a writable ClusterContentLibrary.
+   */
+  customMetadata?: { [key: string]: string };
+  /**
+   * Description is the desired human-readable description of the library item in vCenter. When set, the
+   * description is updated in vCenter to match, and Status.Description reports the actual description.
+   * This field can only be set for items of a writable ClusterContentLibrary.
+   */
+  description?: string;
+  /**
+   * Name is the desired name of the library item in vCenter. When set, the library item is renamed in vCenter
+   * to match, and Status.Name reports the actual name once the rename is done.
+   * This field can only be set for items of a writable ClusterContentLibrary.
+   */
+  name?: string;
+  /**
+   * UUID is the identifier which uniquely identifies the library item in vCenter. This field is immutable.
+   */
+  uuid: string;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ClusterContentLibraryItemStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * DeploymentDefaults describes the default values for deploying a VM from the OVF.
+ * This field is populated only if the library item is of the "Ovf" type.
+ */
+export interface ClusterContentLibraryItemStatusDeploymentDefaults {
+  /**
+   * DeploymentOption is the default deployment option, from the DeploymentOptionSection.
+   */
+  deploymentOption?: string;
+  /**
+   * DeploymentOptions are all the deployment options available in the DeploymentOptionSection.
+   */
+  deploymentOptions?: string[];
+  /**
+   * GuestOSID is the vSphere guest OS identifier of the OperatingSystemSection, e.g. "ubuntu64Guest".
+   */
+  guestOSID?: string;
+  /**
+   * MinCPUs is the number of virtual CPUs required by the VirtualHardwareSection.
+   */
+  minCPUs?: number;
+  /**
+   * MinMemory is the amount of memory required by the VirtualHardwareSection.
+   */
+  minMemory?: number | string;
+  /**
+   * Networks are the names of the networks the OVF connects to by default, from the NetworkSection.
+   */
+  networks?: string[];
+  /**
+   * Properties are the keys of the properties of the ProductSection that can be set at deployment time,
+   * e.g. "user-data" or "hostname".
+   */
+  properties?: string[];
+}
+
+/**
+ * ConfigMapKeyRef selects the key of a ConfigMap that holds the text of a license agreement exceeding
+ * EULAMaxInlineBytes. The ConfigMap is in the namespace of a ContentLibraryItem, or in the namespace of
+ * the content library operator for a ClusterContentLibraryItem.
+ */
+export interface ClusterContentLibraryItemStatusEulasConfigMapKeyRef {
+  /**
+   * The key to select.
+   */
+  key: string;
+  /**
+   * Name of the referent.
+   * More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+   */
+  name?: string;
+  /**
+   * Specify whether the ConfigMap or its key must be defined
+   */
+  optional?: boolean;
+}
+
+/**
+ * EULA describes a license agreement of the EulaSection of an OVF descriptor. Exactly one of Text and
+ * ConfigMapKeyRef is set.
+ */
+export interface ClusterContentLibraryItemStatusEulas {
+  /**
+   * ConfigMapKeyRef selects the key of a ConfigMap that holds the text of a license agreement exceeding
+   * EULAMaxInlineBytes. The ConfigMap is in the namespace of a ContentLibraryItem, or in the namespace of
+   * the content library operator for a ClusterContentLibraryItem.
+   */
+  configMapKeyRef?: ClusterContentLibraryItemStatusEulasConfigMapKeyRef;
+  /**
+   * Text is the text of the license agreement, if it does not exceed EULAMaxInlineBytes.
+   */
+  text?: string;
+}
+
+/**
+ * Checksum is the checksum of the file, as computed by vCenter.
+ */
+export interface ClusterContentLibraryItemStatusFilesChecksum {
+  /**
+   * Algorithm is the algorithm used to calculate the checksum.
+   * Possible values are "SHA1", "SHA256", "SHA512" and "MD5".
+   */
+  algorithm: "SHA1" | "SHA256" | "SHA512" | "MD5";
+  /**
+   * Value is the hex encoded checksum of the file.
+   */
+  value: string;
+}
+
+/**
+ * FileInfo describes a file of a library item.
+ */
+export interface ClusterContentLibraryItemStatusFiles {
+  /**
+   * Cached indicates if the file is on disk in vCenter.
+   */
+  cached: boolean;
+  /**
+   * Checksum is the checksum of the file, as computed by vCenter.
+   */
+  checksum?: ClusterContentLibraryItemStatusFilesChecksum;
+  /**
+   * Name is the name of the file in the library item.
+   */
+  name: string;
+  /**
+   * Size is the size of the file in bytes.
+   */
+  size?: number;
+  /**
+   * Version is the version of the file. It is incremented when the content of the file is changed.
+   */
+  version?: string;
+}
+
+/**
+ * NameAndKindRef refers to an object of another API by its kind and name, e.g. a VirtualMachineImage.
+ * A namespaced object must be in the namespace of the referring resource.
+ */
+export interface ClusterContentLibraryItemStatusImageRefs {
+  /**
+   * APIVersion is the group and version of the API of the object, e.g. "vmoperator.vmware.com/v1alpha1".
+   */
+  apiVersion?: string;
+  /**
+   * Kind is the kind of the object, e.g. "VirtualMachineImage".
+   */
+  kind: string;
+  /**
+   * Name is the name of the object.
+   */
+  name: string;
+}
+
+/**
+ * IsoInfo describes the metadata extracted from the ISO image.
+ * This field is populated only if the library item is of the "Iso" type.
+ */
+export interface ClusterContentLibraryItemStatusIsoInfo {
+  /**
+   * Bootable indicates whether the ISO image contains a boot catalog.
+   */
+  bootable?: boolean;
+  /**
+   * OSHints are the guest OS identifiers detected from the contents of the ISO image, e.g. "ubuntu64Guest".
+   * They are best-effort and must not be relied on for security decisions.
+   */
+  osHints?: string[];
+  /**
+   * Size is the size of the ISO image in bytes.
+   */
+  size?: number;
+  /**
+   * VolumeLabel is the volume label of the ISO image.
+   */
+  volumeLabel?: string;
+}
+
+/**
+ * LastError describes the last error encountered while reconciling the library item, and whether it is retried.
+ * This field is cleared once the library item is reconciled successfully.
+ */
+export interface ClusterContentLibraryItemStatusLastError {
+  /**
+   * Message is a human-readable description of the error.
+   */
+  message: string;
+  /**
+   * NextRetryTime indicates when the controller retries the operation next.
+   * This field is populated only if Retryable is true.
+   */
+  nextRetryTime?: string;
+  /**
+   * Operation is the operation that failed, e.g. "Sync" or "Import".
+   */
+  operation: string;
+  /**
+   * RetryCount is the number of times the operation was retried since it first failed.
+   */
+  retryCount?: number;
+  /**
+   * Retryable indicates whether the controller retries the operation automatically. If false, the error
+   * requires intervention.
+   */
+  retryable: boolean;
+  /**
+   * Time indicates when the error occurred.
+   */
+  time: string;
+}
+
+/**
+ * LastTaskInfo describes the last vCenter task that failed for this library item.
+ */
+export interface ClusterContentLibraryItemStatusLastTaskInfo {
+  /**
+   * CompletionTime indicates the time when the task completed in vCenter.
+   */
+  completionTime?: string;
+  /**
+   * Description is a human-readable description of the task.
+   */
+  description?: string;
+  /**
+   * ErrorMessage is the error reported by vCenter for the task.
+   */
+  errorMessage?: string;
+  /**
+   * ID is the managed object reference of the vCenter task, e.g. "task-1234".
+   */
+  id: string;
+  /**
+   * OperationID is the vCenter operation ID (opID) of the request that started the task.
+   */
+  operationID?: string;
+  /**
+   * QueueTime indicates the time when the task was queued in vCenter.
+   */
+  queueTime?: string;
+  /**
+   * StartTime indicates the time when the task was started in vCenter.
+   */
+  startTime?: string;
+}
+
+/**
+ * PublisherInfo identifies the published library the item was synchronized from.
+ * This field is populated only if SourceLibraryType is "Subscribed".
+ */
+export interface ClusterContentLibraryItemStatusPublisherInfo {
+  /**
+   * LibraryName is the name of the published library in the publishing vCenter, if known.
+   */
+  libraryName?: string;
+  /**
+   * LibraryUUID is the identifier of the published library in the publishing vCenter, if known.
+   */
+  libraryUUID?: string;
+  /**
+   * PublicationURLHost is the host, and port if any, of the subscription URL of the subscribed library.
+   */
+  publicationURLHost?: string;
+  /**
+   * VCenterInstanceUUID is the instance UUID of the publishing vCenter, if known.
+   */
+  vCenterInstanceUUID?: string;
+}
+
+/**
+ * ConfigMapKeyRef selects the key of a ConfigMap that holds the software bill of materials. The ConfigMap
+ * is in the namespace of a ContentLibraryItem, or in the namespace of the content library operator for a
+ * ClusterContentLibraryItem.
+ */
+export interface ClusterContentLibraryItemStatusSbomRefConfigMapKeyRef {
+  /**
+   * The key to select.
+   */
+  key: string;
+  /**
+   * Name of the referent.
+   * More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+   */
+  name?: string;
+  /**
+   * Specify whether the ConfigMap or its key must be defined
+   */
+  optional?: boolean;
+}
+
+/**
+ * SecretKeyRef selects the key of a Secret that holds the software bill of materials. The Secret is in the
+ * same namespace as the ConfigMap of ConfigMapKeyRef would be.
+ */
+export interface ClusterContentLibraryItemStatusSbomRefSecretKeyRef {
+  /**
+   * The key of the secret to select from.  Must be a valid secret key.
+   */
+  key: string;
+  /**
+   * Name of the referent.
+   * More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+   */
+  name?: string;
+  /**
+   * Specify whether the Secret or its key must be defined
+   */
+  optional?: boolean;
+}
+
+/**
+ * SBOMRef refers to the software bill of materials of the current content of the library item, attached
+ * by the pipeline that built the image.
+ */
+export interface ClusterContentLibraryItemStatusSbomRef {
+  /**
+   * ConfigMapKeyRef selects the key of a ConfigMap that holds the software bill of materials. The ConfigMap
+   * is in the namespace of a ContentLibraryItem, or in the namespace of the content library operator for a
+   * ClusterContentLibraryItem.
+   */
+  configMapKeyRef?: ClusterContentLibraryItemStatusSbomRefConfigMapKeyRef;
+  /**
+   * Format is the format of the software bill of materials.
+   * Possible values are "SPDX" and "CycloneDX".
+   */
+  format: "SPDX" | "CycloneDX";
+  /**
+   * SecretKeyRef selects the key of a Secret that holds the software bill of materials. The Secret is in the
+   * same namespace as the ConfigMap of ConfigMapKeyRef would be.
+   */
+  secretKeyRef?: ClusterContentLibraryItemStatusSbomRefSecretKeyRef;
+  /**
+   * URL is the HTTP(S) endpoint that serves the software bill of materials.
+   */
+  url?: string;
+}
+
+/**
+ * SignatureInfo describes a signature of the content of a library item.
+ */
+export interface ClusterContentLibraryItemStatusSignatures {
+  /**
+   * Digest is the digest of the signed content, e.g. "sha256:<hex>".
+   */
+  digest?: string;
+  /**
+   * Message describes why the signature could not be verified.
+   */
+  message?: string;
+  /**
+   * SignedTime indicates the time when the content was signed, if known.
+   */
+  signedTime?: string;
+  /**
+   * Signer identifies the signer, e.g. the subject of the signing certificate or the ID of the signing key.
+   */
+  signer: string;
+  /**
+   * Verified indicates whether the signature was verified against the content of the library item.
+   */
+  verified: boolean;
+}
+
+/**
+ * SourceItemRef refers to the library item this library item was copied from, if it was copied from
+ * another library.
+ */
+export interface ClusterContentLibraryItemStatusSourceItemRef {
+  /**
+   * Kind is the kind of the source library item.
+   * Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+   */
+  kind: "ContentLibraryItem" | "ClusterContentLibraryItem";
+  /**
+   * Name is the name of the source library item resource.
+   */
+  name: string;
+  /**
+   * Namespace is the namespace of the source library item. It is empty for a ClusterContentLibraryItem.
+   */
+  namespace?: string;
+  /**
+   * UUID is the identifier of the source library item in vCenter, which remains valid after the source
+   * library item resource is deleted.
+   */
+  uuid?: string;
+}
+
+/**
+ * SyncProgress describes the progress of the ongoing transfer of the library item content.
+ * This field is populated only while the Transferring condition is true.
+ */
+export interface ClusterContentLibraryItemStatusSyncProgress {
+  /**
+   * BytesTransferred is the number of bytes that have been transferred so far.
+   */
+  bytesTransferred?: number;
+  /**
+   * EstimatedCompletionTime is the estimated time at which the transfer completes.
+   */
+  estimatedCompletionTime?: string;
+  /**
+   * Percentage is the completion percentage of the transfer.
+   */
+  percentage?: number;
+  /**
+   * TotalBytes is the total number of bytes to transfer, if known.
+   */
+  totalBytes?: number;
+}
+
+/**
+ * ClusterContentLibraryItemStatus defines the observed state of ClusterContentLibraryItem.
+ */
+export interface ClusterContentLibraryItemStatus {
+  /**
+   * CacheMessage is a human readable message with details about the CacheStatus, e.g. the error that
+   * caused caching to fail.
+   */
+  cacheMessage?: string;
+  /**
+   * CacheReason is a brief CamelCase reason for the CacheStatus, e.g. "NotRequested" or "CachingFailed".
+   */
+  cacheReason?: string;
+  /**
+   * CacheStatus indicates whether the library item files are on disk in vCenter. It supersedes Cached,
+   * which is true only if CacheStatus is "Cached".
+   * Possible values are "Cached", "NotCached", "Caching", "Evicting" and "Error".
+   */
+  cacheStatus?: "Cached" | "NotCached" | "Caching" | "Evicting" | "Error";
+  /**
+   * Cached indicates if the library item files are on disk in vCenter.
+   * 
+   * Deprecated: Use CacheStatus, which also reports why the library item files are not on disk.
+   */
+  cached: boolean;
+  /**
+   * CloneGeneration is the number of copies between the original library item and this library item, e.g.
+   * 2 for the namespace copy of a regional copy of a golden image. It is 0 for library items that were not
+   * copied.
+   */
+  cloneGeneration?: number;
+  /**
+   * ClusterContentLibraryRef is the name of the ClusterContentLibrary resource that this item belongs to.
+   */
+  clusterContentLibraryRef: string;
+  /**
+   * Conditions describes the current condition information of the ClusterContentLibraryItem.
+   */
+  conditions?: ClusterContentLibraryItemStatusConditions[];
+  /**
+   * ContentVersion indicates the version of the library item content.
+   * This value is incremented when the files comprising the content library item are changed in vCenter.
+   */
+  contentVersion: string;
+  /**
+   * CreationTime indicates the date and time when this library item was created.
+   */
+  creationTime: string;
+  /**
+   * CustomMetadata is the custom metadata of the library item in vCenter.
+   */
+  customMetadata?: { [key: string]: string };
+  /**
+   * DeployCount is the number of VMs deployed from the library item. It is incremented by the consumers of
+   * the library item, e.g. vm-operator, with RecordDeploy, so that unused images can be identified.
+   */
+  deployCount?: number;
+  /**
+   * DeploymentDefaults describes the default values for deploying a VM from the OVF.
+   * This field is populated only if the library item is of the "Ovf" type.
+   */
+  deploymentDefaults?: ClusterContentLibraryItemStatusDeploymentDefaults;
+  /**
+   * Description is a human-readable description for this library item.
+   */
+  description?: string;
+  /**
+   * EULAs are the license agreements of the EulaSection of the OVF descriptor, which must be presented to
+   * and accepted by users deploying VMs from the library item.
+   * This field is populated only if the library item is of the "Ovf" type.
+   */
+  eulas?: ClusterContentLibraryItemStatusEulas[];
+  /**
+   * Files describes the files of the library item.
+   */
+  files?: ClusterContentLibraryItemStatusFiles[];
+  /**
+   * HardwareVersion is the virtual hardware version of the VMs described by the OVF, e.g. 19 for "vmx-19".
+   * This field is populated only if the library item is of the "Ovf" type.
+   */
+  hardwareVersion?: number;
+  /**
+   * ImageRefs refers to the ClusterVirtualMachineImage resources generated from the library item, so that the image
+   * of a library item can be found without searching by UUID.
+   */
+  imageRefs?: ClusterContentLibraryItemStatusImageRefs[];
+  /**
+   * IsoInfo describes the metadata extracted from the ISO image.
+   * This field is populated only if the library item is of the "Iso" type.
+   */
+  isoInfo?: ClusterContentLibraryItemStatusIsoInfo;
+  /**
+   * LastDeployTime indicates the time when a VM was last deployed from the library item. It is set by the
+   * consumers of the library item together with DeployCount.
+   */
+  lastDeployTime?: string;
+  /**
+   * LastDriftCheckTime indicates the time when the library item was last compared with vCenter to detect
+   * out-of-band changes.
+   */
+  lastDriftCheckTime?: string;
+  /**
+   * LastError describes the last error encountered while reconciling the library item, and whether it is retried.
+   * This field is cleared once the library item is reconciled successfully.
+   */
+  lastError?: ClusterContentLibraryItemStatusLastError;
+  /**
+   * LastModifiedTime indicates the date and time when this library item was last updated.
+   * This field is updated when the library item properties are changed or the file content is changed.
+   */
+  lastModifiedTime: string;
+  /**
+   * LastObservedTime indicates the time when the controller last reconciled the library item. It is updated on
+   * every reconciliation, even when nothing changed, so that a wedged controller can be detected.
+   */
+  lastObservedTime?: string;
+  /**
+   * LastSyncTime indicates the date and time when this library item was last synchronized.
+   * This field applies only to subscribed library items.
+   */
+  lastSyncTime?: string;
+  /**
+   * LastTaskInfo describes the last vCenter task that failed for this library item.
+   */
+  lastTaskInfo?: ClusterContentLibraryItemStatusLastTaskInfo;
+  /**
+   * LastTransferThroughput is the average rate, in bytes per second, of the last completed transfer of the library item content.
+   */
+  lastTransferThroughput?: number;
+  /**
+   * LastUsedTime indicates the time when the content of the library item was last used, e.g. to deploy
+   * a VM. It drives the eviction of the cached content of the item.
+   */
+  lastUsedTime?: string;
+  /**
+   * MetadataVersion indicates the version of the library item metadata.
+   * This value is incremented when the library item properties such as name or description are changed in vCenter.
+   */
+  metadataVersion: string;
+  /**
+   * MinSupportedHWVersion is the highest virtual hardware version supported by all the target clusters,
+   * i.e. the lowest of the highest hardware versions the clusters support. The HardwareVersionSupported
+   * condition is false if HardwareVersion exceeds it.
+   * This field is populated only if the library item is of the "Ovf" type.
+   */
+  minSupportedHWVersion?: number;
+  /**
+   * Name specifies the name of the content library item in vCenter.
+   */
+  name: string;
+  /**
+   * OCIRef is the OCI-style reference of the current content of the library item, of the form
+   * "registry/<library-uuid>/<item-uuid>:v<content-version>@<digest>", so that OCI signing, SBOM and policy
+   * tools can refer to it. This field is populated only if the operator is configured with a registry.
+   */
+  ociRef?: string;
+  /**
+   * Phase indicates the lifecycle phase of the library item.
+   * Possible values are "Available" and "Orphaned".
+   */
+  phase?: string;
+  /**
+   * PublisherInfo identifies the published library the item was synchronized from.
+   * This field is populated only if SourceLibraryType is "Subscribed".
+   */
+  publisherInfo?: ClusterContentLibraryItemStatusPublisherInfo;
+  /**
+   * RawType is the type of the library item as reported by vCenter, e.g. "ovf" or "iso". It is recorded for
+   * every item, and in particular explains the items of the "Unknown" type.
+   */
+  rawType?: string;
+  /**
+   * Ready denotes that the library item is ready to be used.
+   */
+  ready: boolean;
+  /**
+   * SBOMRef refers to the software bill of materials of the current content of the library item, attached
+   * by the pipeline that built the image.
+   */
+  sbomRef?: ClusterContentLibraryItemStatusSbomRef;
+  /**
+   * Signatures describes the signatures found at Spec.AttestationRef.
+   */
+  signatures?: ClusterContentLibraryItemStatusSignatures[];
+  /**
+   * Size indicates the library item size in bytes
+   */
+  size?: number;
+  /**
+   * SourceItemRef refers to the library item this library item was copied from, if it was copied from
+   * another library.
+   */
+  sourceItemRef?: ClusterContentLibraryItemStatusSourceItemRef;
+  /**
+   * SourceLibraryType indicates the type of the ClusterContentLibrary this item belongs to.
+   * Possible types are "Local" and "Subscribed".
+   */
+  sourceLibraryType?: string;
+  /**
+   * StorageURIs lists the datastore URIs of the library item files, e.g.
+   * "ds:///vmfs/volumes/<datastore-uuid>/contentlib-<library-uuid>/<item-uuid>/disk-0.vmdk".
+   * This field is populated only when the library item files are cached.
+   */
+  storageURIs?: string[];
+  /**
+   * Summary is a one-line human readable summary of the state of the ClusterContentLibraryItem, e.g. "Ready" or
+   * "Error: publisher certificate expired", derived from its conditions by conditions.ReadyMessage.
+   */
+  summary?: string;
+  /**
+   * SyncProgress describes the progress of the ongoing transfer of the library item content.
+   * This field is populated only while the Transferring condition is true.
+   */
+  syncProgress?: ClusterContentLibraryItemStatusSyncProgress;
+  /**
+   * Type string indicates the type of the library item in vCenter.
+   * Possible types are "Ovf", "Iso", "File" and "Unknown". Items of a type that is not supported by this API
+   * have the "Unknown" type, and their TypeSupported condition is false.
+   */
+  type: string;
+}
+
+/**
+ * ClusterContentLibraryItem is the schema for the content library item API at the cluster scope.
+ * Currently, ClusterContentLibraryItem are immutable to end users.
+ */
+export interface ClusterContentLibraryItem {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ClusterContentLibraryItemSpec defines the desired state of a ClusterContentLibraryItem.
+   */
+  spec?: ClusterContentLibraryItemSpec;
+  /**
+   * ClusterContentLibraryItemStatus defines the observed state of ClusterContentLibraryItem.
+   */
+  status?: ClusterContentLibraryItemStatus;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ClusterContentLibraryItemSummaryStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * ItemDigest is a compact description of a library item, used by consumers that do not need the full
+ * library item resource.
+ */
+export interface ClusterContentLibraryItemSummaryStatusItems {
+  /**
+   * ContentVersion indicates the version of the library item content.
+   */
+  contentVersion?: string;
+  /**
+   * Name is the name of the library item resource.
+   */
+  name: string;
+  /**
+   * Ready denotes that the library item is ready to be used.
+   */
+  ready: boolean;
+  /**
+   * UUID is the identifier of the library item in vCenter.
+   */
+  uuid: string;
+}
+
+/**
+ * ItemSummaryStatus defines the observed state of the library items of a library.
+ */
+export interface ClusterContentLibraryItemSummaryStatus {
+  /**
+   * Conditions describes the current condition information of the summary. Ready is True once the summary
+   * reflects every library item of the library.
+   */
+  conditions?: ClusterContentLibraryItemSummaryStatusConditions[];
+  /**
+   * Items is the digest of every library item in the library, sorted by name. At most 10000 library items
+   * are listed, which keeps the resource within the size limit of the API server.
+   */
+  items?: ClusterContentLibraryItemSummaryStatusItems[];
+  /**
+   * LastUpdateTime indicates the time when the summary was last updated.
+   */
+  lastUpdateTime?: string;
+  /**
+   * ReadyItems is the number of library items in the library that are ready to be used.
+   */
+  readyItems?: number;
+  /**
+   * TotalItems is the number of library items in the library.
+   */
+  totalItems?: number;
+}
+
+/**
+ * ClusterContentLibraryItemSummary is the schema for the content library item summary API at the cluster scope.
+ * The content library operator maintains one ClusterContentLibraryItemSummary per ClusterContentLibrary, with
+ * the same name as the library.
+ */
+export interface ClusterContentLibraryItemSummary {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ItemSummaryStatus defines the observed state of the library items of a library.
+   */
+  status?: ClusterContentLibraryItemSummaryStatus;
+}
+
+/**
+ * CacheEvictionPolicy describes when the cached content of the library items is evicted to reclaim space.
+ * If unset, content is never evicted. This field applies only if the library is of the "Subscribed" type
+ * and synchronizes on demand.
+ */
+export interface ContentLibrarySpecCacheEvictionPolicy {
+  /**
+   * MaxCacheSize is the maximum total size of the cached content of the library items. When it is
+   * exceeded, the content of the least recently used items is evicted.
+   */
+  maxCacheSize?: number | string;
+  /**
+   * MaxUnusedDuration is the duration after which the content of an item that was not used is evicted,
+   * e.g. "720h" for 30 days.
+   */
+  maxUnusedDuration?: string;
+}
+
+/**
+ * StorageBacking is the storage backing of the library created in vCenter. If unset, the datastore is
+ * selected from Spec.StoragePolicyID or Spec.StorageClassName.
+ */
+export interface ContentLibrarySpecCreateStorageBacking {
+  /**
+   * DatastoreID indicates the identifier of the datastore used to store the content
+   * in the library for the "Datastore" storageType in vCenter.
+   */
+  datastoreID?: string;
+  /**
+   * DatastoreName indicates the name of the datastore identified by DatastoreID.
+   */
+  datastoreName?: string;
+  /**
+   * StoragePodID indicates the identifier of the datastore cluster used to store the content in the library
+   * for the "DatastoreCluster" storageType in vCenter, e.g. "group-p123". DatastoreID then indicates the
+   * datastore of the cluster selected by Storage DRS, if known.
+   */
+  storagePodID?: string;
+  /**
+   * StoragePodName indicates the name of the datastore cluster identified by StoragePodID.
+   */
+  storagePodName?: string;
+  /**
+   * Type indicates the type of storage where the content would be stored.
+   * Possible values are "Datastore", "DatastoreCluster" and "Other".
+   */
+  type: string;
+}
+
+/**
+ * Subscription defines how the created library synchronizes to a remote source. Either this field or
+ * Spec.Subscription is required if Type is "Subscribed". The publication of a created library of the
+ * "Local" type is set with Spec.Publish.
+ * 
+ * Deprecated: Use Spec.Subscription, which can also be changed after the library is created.
+ */
+export interface ContentLibrarySpecCreateSubscription {
+  /**
+   * AutomaticSyncEnabled indicates whether the library should participate in automatic library synchronization.
+   */
+  automaticSyncEnabled: boolean;
+  /**
+   * OnDemand indicates whether a library item’s content will be synchronized only on demand.
+   */
+  onDemand: boolean;
+  /**
+   * SubscriptionURL is the URL of the endpoint where the metadata for the remotely published library is being served.
+   * The value from PublishInfo.PublishURL of the published library should be used while creating a subscribed library.
+   */
+  subscriptionURL: string;
+}
+
+/**
+ * Create describes the library that the operator creates in vCenter. The UUID of the created library is
+ * reported in Status.UUID. This field is immutable.
+ */
+export interface ContentLibrarySpecCreate {
+  /**
+   * Description is the description of the library created in vCenter.
+   */
+  description?: string;
+  /**
+   * Name is the name of the library created in vCenter.
+   */
+  name: string;
+  /**
+   * StorageBacking is the storage backing of the library created in vCenter. If unset, the datastore is
+   * selected from Spec.StoragePolicyID or Spec.StorageClassName.
+   */
+  storageBacking?: ContentLibrarySpecCreateStorageBacking;
+  /**
+   * Subscription defines how the created library synchronizes to a remote source. Either this field or
+   * Spec.Subscription is required if Type is "Subscribed". The publication of a created library of the
+   * "Local" type is set with Spec.Publish.
+   * 
+   * Deprecated: Use Spec.Subscription, which can also be changed after the library is created.
+   */
+  subscription?: ContentLibrarySpecCreateSubscription;
+  /**
+   * Type is the type of the library created in vCenter.
+   * Possible types are "Local" and "Subscribed".
+   */
+  type: "Local" | "Subscribed";
+}
+
+/**
+ * ItemMetadataTemplate describes the labels and annotations set on the ContentLibraryItems of the
+ * library, so that catalog-wide labeling policies do not require a separate mutating controller.
+ * Changes are applied to the existing items at the next synchronization.
+ */
+export interface ContentLibrarySpecItemMetadataTemplate {
+  /**
+   * Annotations are the annotations set on the library item resources. The annotations with the
+   * imageregistry.vmware.com/ prefix, once rendered, are reserved and cannot be set.
+   */
+  annotations?: { [key: string]: string };
+  /**
+   * Labels are the labels set on the library item resources. The labels with the imageregistry.vmware.com/
+   * prefix, once rendered, are reserved for the well-known labels and cannot be set.
+   */
+  labels?: { [key: string]: string };
+}
+
+/**
+ * CredentialsSecretRef refers to a Secret containing the "username" and "password" keys used to
+ * authenticate against the proxy. If the namespace is omitted, the namespace of the library is assumed.
+ */
+export interface ContentLibrarySpecProxyCredentialsSecretRef {
+  /**
+   * Name is unique within a namespace to reference a secret resource.
+   */
+  name?: string;
+  /**
+   * Namespace defines the space within which the secret name must be unique.
+   */
+  namespace?: string;
+}
+
+/**
+ * Proxy specifies the proxy used to reach the subscription URL of the library. If unset, the vCenter-wide
+ * proxy settings apply. This field applies only if the library is of the "Subscribed" type.
+ */
+export interface ContentLibrarySpecProxy {
+  /**
+   * CredentialsSecretRef refers to a Secret containing the "username" and "password" keys used to
+   * authenticate against the proxy. If the namespace is omitted, the namespace of the library is assumed.
+   */
+  credentialsSecretRef?: ContentLibrarySpecProxyCredentialsSecretRef;
+  /**
+   * HTTPProxy is the URL of the proxy server used for HTTP requests, e.g. "http://proxy.example.com:3128".
+   */
+  httpProxy?: string;
+  /**
+   * HTTPSProxy is the URL of the proxy server used for HTTPS requests.
+   */
+  httpsProxy?: string;
+  /**
+   * NoProxy is a list of host names, domain suffixes, IP addresses or CIDRs that must be reached directly
+   * instead of through the proxy.
+   */
+  noProxy?: string[];
+}
+
+/**
+ * PasswordSecretRef refers to a Secret in the namespace of the library whose "password" key holds the
+ * password of the published library. This field is required if Method is "Basic".
+ */
+export interface ContentLibrarySpecPublishAuthenticationPasswordSecretRef {
+  /**
+   * Name of the referent.
+   * More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+   */
+  name?: string;
+}
+
+/**
+ * Authentication defines how subscribers authenticate to the published library.
+ * If unset, subscribers do not need to authenticate.
+ */
+export interface ContentLibrarySpecPublishAuthentication {
+  /**
+   * Method is the authentication method subscribers must use.
+   * Possible values are "None" and "Basic".
+   */
+  method: "None" | "Basic";
+  /**
+   * PasswordSecretRef refers to a Secret in the namespace of the library whose "password" key holds the
+   * password of the published library. This field is required if Method is "Basic".
+   */
+  passwordSecretRef?: ContentLibrarySpecPublishAuthenticationPasswordSecretRef;
+}
+
+/**
+ * Publish defines the desired publication of the library, and Status.PublishInfo reports the resulting
+ * publish URL. If unset, the publication is not managed from Kubernetes.
+ * This field applies only if the library is of the "Local" type.
+ */
+export interface ContentLibrarySpecPublish {
+  /**
+   * Authentication defines how subscribers authenticate to the published library.
+   * If unset, subscribers do not need to authenticate.
+   */
+  authentication?: ContentLibrarySpecPublishAuthentication;
+  /**
+   * Enabled indicates whether the library is published so that it can be subscribed to.
+   */
+  enabled: boolean;
+  /**
+   * PersistJSONEnabled indicates whether the library and library item metadata are persisted as JSON files
+   * on the storage backing of the library, so the library can be published from the storage directly.
+   */
+  persistJSONEnabled?: boolean;
+}
+
+/**
+ * PasswordSecretRef refers to a Secret in the namespace of the library whose "password" key holds the
+ * password of the published library, used with HTTP basic authentication. If unset, the library subscribes
+ * without authentication.
+ */
+export interface ContentLibrarySpecSubscriptionPasswordSecretRef {
+  /**
+   * Name of the referent.
+   * More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+   */
+  name?: string;
+}
+
+/**
+ * Subscription defines the desired subscription of the library, and Status.SubscriptionInfo reports the
+ * subscription in vCenter. Changes are applied to the library in vCenter. If unset, the subscription is not
+ * managed from Kubernetes.
+ * This field applies only if the library is of the "Subscribed" type.
+ */
+export interface ContentLibrarySpecSubscription {
+  /**
+   * AutomaticSyncEnabled indicates whether the library should participate in automatic library synchronization.
+   */
+  automaticSyncEnabled: boolean;
+  /**
+   * OnDemand indicates whether a library item’s content will be synchronized only on demand.
+   */
+  onDemand: boolean;
+  /**
+   * PasswordSecretRef refers to a Secret in the namespace of the library whose "password" key holds the
+   * password of the published library, used with HTTP basic authentication. If unset, the library subscribes
+   * without authentication.
+   */
+  passwordSecretRef?: ContentLibrarySpecSubscriptionPasswordSecretRef;
+  /**
+   * SSLThumbprint is the SHA-1 thumbprint of the certificate presented by the subscription URL, e.g.
+   * "AB:CD:...:EF", which vCenter trusts when the certificate is not signed by a trusted authority.
+   */
+  sslThumbprint?: string;
+  /**
+   * SubscriptionURL is the URL of the endpoint where the metadata for the remotely published library is being served.
+   * The value from PublishInfo.PublishURL of the published library should be used.
+   */
+  subscriptionURL: string;
+}
+
+/**
+ * SyncFailurePolicy describes how the failed synchronizations of the library are retried. If unset, the
+ * defaults of the content library operator apply.
+ * This field applies only if the library is of the "Subscribed" type.
+ */
+export interface ContentLibrarySpecSyncFailurePolicy {
+  /**
+   * MaxBackoff is the maximum delay between two retries of a failed synchronization. The delay grows
+   * exponentially with the number of consecutive failures up to MaxBackoff.
+   */
+  maxBackoff?: string;
+  /**
+   * MaxConsecutiveFailures is the number of synchronizations that can fail in a row before the library is
+   * marked Degraded. Synchronizations are still retried once the library is degraded, with the maximum
+   * backoff.
+   */
+  maxConsecutiveFailures?: number;
+}
+
+/**
+ * VCenterRef refers to the vCenter the library belongs to. If unset, the default vCenter of the
+ * supervisor is assumed. This field is immutable.
+ */
+export interface ContentLibrarySpecVCenterRef {
+  /**
+   * Name is the name of the vCenter connection, e.g. a Secret holding the vCenter endpoint and credentials,
+   * in the namespace of the content library operator.
+   */
+  name: string;
+}
+
+/**
+ * ContentLibrarySpec defines the desired state of a ContentLibrary.
+ * A ContentLibrary either adopts an existing library in vCenter, identified by UUID, or has the operator
+ * create a new library described by Create. Exactly one of the two fields must be set, and a ContentLibrary
+ * cannot switch between the two modes.
+ */
+export interface ContentLibrarySpec {
+  /**
+   * CacheEvictionPolicy describes when the cached content of the library items is evicted to reclaim space.
+   * If unset, content is never evicted. This field applies only if the library is of the "Subscribed" type
+   * and synchronizes on demand.
+   */
+  cacheEvictionPolicy?: ContentLibrarySpecCacheEvictionPolicy;
+  /**
+   * Create describes the library that the operator creates in vCenter. The UUID of the created library is
+   * reported in Status.UUID. This field is immutable.
+   */
+  create?: ContentLibrarySpecCreate;
+  /**
+   * DeletionPolicy indicates whether the library and its content are deleted in vCenter when the
+   * ContentLibrary is deleted, or merely released from management. Defaults to "Retain".
+   * Only a library created by the operator can have the "Delete" policy, and only if the ContentLibrary
+   * has the ConfirmDeleteAnnotationKey annotation.
+   * Possible values are "Retain" and "Delete".
+   */
+  deletionPolicy?: "Retain" | "Delete";
+  /**
+   * ItemMetadataTemplate describes the labels and annotations set on the ContentLibraryItems of the
+   * library, so that catalog-wide labeling policies do not require a separate mutating controller.
+   * Changes are applied to the existing items at the next synchronization.
+   */
+  itemMetadataTemplate?: ContentLibrarySpecItemMetadataTemplate;
+  /**
+   * Proxy specifies the proxy used to reach the subscription URL of the library. If unset, the vCenter-wide
+   * proxy settings apply. This field applies only if the library is of the "Subscribed" type.
+   */
+  proxy?: ContentLibrarySpecProxy;
+  /**
+   * Publish defines the desired publication of the library, and Status.PublishInfo reports the resulting
+   * publish URL. If unset, the publication is not managed from Kubernetes.
+   * This field applies only if the library is of the "Local" type.
+   */
+  publish?: ContentLibrarySpecPublish;
+  /**
+   * ResyncIntervalSeconds is the interval, in seconds, at which the library is reconciled with vCenter. It
+   * overrides the defaultSyncInterval of the ContentLibraryConfiguration for this library, e.g. to re-list
+   * large static libraries less often than rapidly changing ones. If unset, the default interval applies.
+   */
+  resyncIntervalSeconds?: number;
+  /**
+   * StorageClassName is the name of a StorageClass of the namespace whose storage policy is used to select
+   * the datastore of a writable library created by the operator. It can only be set if Create is set and
+   * Writable is true, and is mutually exclusive with StoragePolicyID. This field is immutable.
+   */
+  storageClassName?: string;
+  /**
+   * StoragePolicyID is the identifier of the vCenter storage policy (SPBM) used to select the datastore of
+   * a writable library created by the operator. It can only be set if Create is set and Writable is true,
+   * and is mutually exclusive with StorageClassName. This field is immutable.
+   */
+  storagePolicyID?: string;
+  /**
+   * Subscription defines the desired subscription of the library, and Status.SubscriptionInfo reports the
+   * subscription in vCenter. Changes are applied to the library in vCenter. If unset, the subscription is not
+   * managed from Kubernetes.
+   * This field applies only if the library is of the "Subscribed" type.
+   */
+  subscription?: ContentLibrarySpecSubscription;
+  /**
+   * SyncFailurePolicy describes how the failed synchronizations of the library are retried. If unset, the
+   * defaults of the content library operator apply.
+   * This field applies only if the library is of the "Subscribed" type.
+   */
+  syncFailurePolicy?: ContentLibrarySpecSyncFailurePolicy;
+  /**
+   * TransferRateLimit is the maximum rate, in bytes per second, at which the content of the library items
+   * is transferred when the library is synchronized, e.g. "100Mi". If unset, transfers are not limited.
+   */
+  transferRateLimit?: number | string;
+  /**
+   * UUID is the identifier which uniquely identifies the library in vCenter. This field is immutable.
+   */
+  uuid?: string;
+  /**
+   * VCenterRef refers to the vCenter the library belongs to. If unset, the default vCenter of the
+   * supervisor is assumed. This field is immutable.
+   */
+  vCenterRef?: ContentLibrarySpecVCenterRef;
+  /**
+   * Writable flag indicates if the users can create new library items in this library.
+   */
+  writable: boolean;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * LastError describes the last error encountered while reconciling the library, and whether it is retried.
+ * This field is cleared once the library is reconciled successfully.
+ */
+export interface ContentLibraryStatusLastError {
+  /**
+   * Message is a human-readable description of the error.
+   */
+  message: string;
+  /**
+   * NextRetryTime indicates when the controller retries the operation next.
+   * This field is populated only if Retryable is true.
+   */
+  nextRetryTime?: string;
+  /**
+   * Operation is the operation that failed, e.g. "Sync" or "Import".
+   */
+  operation: string;
+  /**
+   * RetryCount is the number of times the operation was retried since it first failed.
+   */
+  retryCount?: number;
+  /**
+   * Retryable indicates whether the controller retries the operation automatically. If false, the error
+   * requires intervention.
+   */
+  retryable: boolean;
+  /**
+   * Time indicates when the error occurred.
+   */
+  time: string;
+}
+
+/**
+ * LastTaskInfo describes the last vCenter task that failed for this library.
+ */
+export interface ContentLibraryStatusLastTaskInfo {
+  /**
+   * CompletionTime indicates the time when the task completed in vCenter.
+   */
+  completionTime?: string;
+  /**
+   * Description is a human-readable description of the task.
+   */
+  description?: string;
+  /**
+   * ErrorMessage is the error reported by vCenter for the task.
+   */
+  errorMessage?: string;
+  /**
+   * ID is the managed object reference of the vCenter task, e.g. "task-1234".
+   */
+  id: string;
+  /**
+   * OperationID is the vCenter operation ID (opID) of the request that started the task.
+   */
+  operationID?: string;
+  /**
+   * QueueTime indicates the time when the task was queued in vCenter.
+   */
+  queueTime?: string;
+  /**
+   * StartTime indicates the time when the task was started in vCenter.
+   */
+  startTime?: string;
+}
+
+/**
+ * Permission describes a vCenter principal that holds a role granting privileges to modify a library,
+ * either on the library itself or inherited from a parent object in vCenter.
+ */
+export interface ContentLibraryStatusPermissionsEntries {
+  /**
+   * Group indicates whether the principal is a group.
+   */
+  group?: boolean;
+  /**
+   * Inherited indicates whether the permission is inherited from a parent object of the library in vCenter.
+   */
+  inherited?: boolean;
+  /**
+   * Principal is the name of the vCenter user or group, e.g. "Administrators@vsphere.local".
+   */
+  principal: string;
+  /**
+   * Role is the name of the vCenter role held by the principal.
+   */
+  role: string;
+}
+
+/**
+ * Permissions summarizes the vCenter principals that can modify the library, so that they can be audited
+ * from Kubernetes. It is refreshed periodically.
+ */
+export interface ContentLibraryStatusPermissions {
+  /**
+   * Entries are the vCenter principals that can modify the library, sorted by principal.
+   */
+  entries?: ContentLibraryStatusPermissionsEntries[];
+  /**
+   * LastRefreshTime indicates the time when the permissions were last read from vCenter.
+   */
+  lastRefreshTime?: string;
+}
+
+/**
+ * ProviderCapabilities describes the content library features supported by the vCenter the library
+ * belongs to. It is refreshed when the version of the vCenter changes.
+ */
+export interface ContentLibraryStatusProviderCapabilities {
+  /**
+   * MaxItemSize is the maximum size of the content of a library item supported by the vCenter. If unset,
+   * the size is not limited.
+   */
+  maxItemSize?: number | string;
+  /**
+   * SupportsOVFMultiSite indicates whether the OVF templates of the library can be synchronized to
+   * subscribed libraries of other vCenters, e.g. of another site.
+   */
+  supportsOVFMultiSite?: boolean;
+  /**
+   * SupportsSecurityPolicies indicates whether security policies, such as OVF signature verification, can
+   * be applied to the library.
+   */
+  supportsSecurityPolicies?: boolean;
+  /**
+   * VCenterBuild is the build number of the vCenter the capabilities were derived from, e.g. "21560480".
+   */
+  vCenterBuild?: string;
+  /**
+   * VCenterVersion is the version of the vCenter the capabilities were derived from, e.g. "8.0.1".
+   */
+  vCenterVersion?: string;
+}
+
+/**
+ * Published indicates how the library is published so that it can be subscribed to by a remote subscribed library.
+ */
+export interface ContentLibraryStatusPublishInfo {
+  /**
+   * PersistJSONEnabled reports whether vCenter persists the library and library item metadata of the library
+   * as JSON files on its storage backing, as observed in the publish settings of the library in vCenter. It
+   * may differ from Spec.Publish.PersistJSONEnabled until the operator applied the spec.
+   */
+  persistJSONEnabled?: boolean;
+  /**
+   * PublishURL is the URL to which the library metadata is published by the vSphere Content Library Service.
+   * This value can be used to set the SubscriptionInfo.subscriptionURL property when creating a subscribed library.
+   */
+  publishURL: string;
+  /**
+   * Published indicates if the local library is published.
+   */
+  published: boolean;
+  /**
+   * SubscriptionCount is the number of subscribed libraries, in this vCenter or remote ones, that subscribe
+   * to the published library.
+   */
+  subscriptionCount?: number;
+}
+
+/**
+ * StorageBacking indicates the default storage backing available for this library in vCenter.
+ */
+export interface ContentLibraryStatusStorageBacking {
+  /**
+   * DatastoreID indicates the identifier of the datastore used to store the content
+   * in the library for the "Datastore" storageType in vCenter.
+   */
+  datastoreID?: string;
+  /**
+   * DatastoreName indicates the name of the datastore identified by DatastoreID.
+   */
+  datastoreName?: string;
+  /**
+   * StoragePodID indicates the identifier of the datastore cluster used to store the content in the library
+   * for the "DatastoreCluster" storageType in vCenter, e.g. "group-p123". DatastoreID then indicates the
+   * datastore of the cluster selected by Storage DRS, if known.
+   */
+  storagePodID?: string;
+  /**
+   * StoragePodName indicates the name of the datastore cluster identified by StoragePodID.
+   */
+  storagePodName?: string;
+  /**
+   * Type indicates the type of storage where the content would be stored.
+   * Possible values are "Datastore", "DatastoreCluster" and "Other".
+   */
+  type: string;
+}
+
+/**
+ * SubscriptionInfo defines how the subscribed library synchronizes to a remote source.
+ * This field is populated only if the library is of the "Subscribed" type.
+ */
+export interface ContentLibraryStatusSubscriptionInfo {
+  /**
+   * AutomaticSyncEnabled indicates whether the library should participate in automatic library synchronization.
+   */
+  automaticSyncEnabled: boolean;
+  /**
+   * OnDemand indicates whether a library item’s content will be synchronized only on demand.
+   */
+  onDemand: boolean;
+  /**
+   * SubscriptionURL is the URL of the endpoint where the metadata for the remotely published library is being served.
+   * The value from PublishInfo.PublishURL of the published library should be used while creating a subscribed library.
+   */
+  subscriptionURL: string;
+}
+
+/**
+ * ContentLibraryStatus defines the observed state of ContentLibrary.
+ */
+export interface ContentLibraryStatus {
+  /**
+   * Conditions describes the current condition information of the ContentLibrary.
+   */
+  conditions?: ContentLibraryStatusConditions[];
+  /**
+   * ConsecutiveFailures is the number of synchronizations of the library that failed in a row. It is reset
+   * when a synchronization succeeds.
+   */
+  consecutiveFailures?: number;
+  /**
+   * CreationTime indicates the date and time when this library was created.
+   */
+  creationTime: string;
+  /**
+   * Description is a human-readable description for this library in vCenter.
+   */
+  description?: string;
+  /**
+   * LastDriftCheckTime indicates the time when the library was last compared with vCenter to detect
+   * out-of-band changes.
+   */
+  lastDriftCheckTime?: string;
+  /**
+   * LastError describes the last error encountered while reconciling the library, and whether it is retried.
+   * This field is cleared once the library is reconciled successfully.
+   */
+  lastError?: ContentLibraryStatusLastError;
+  /**
+   * LastModifiedTime indicates the date and time when this library was last updated.
+   * This field is updated only when the library properties are changed. This field is not updated when a library
+   * item is added, modified or deleted or its content is changed.
+   */
+  lastModifiedTime: string;
+  /**
+   * LastObservedTime indicates the time when the controller last reconciled the library. It is updated on
+   * every reconciliation, even when nothing changed, so that a wedged controller can be detected.
+   */
+  lastObservedTime?: string;
+  /**
+   * LastSyncTime indicates the date and time when this library was last synchronized.
+   * This field applies only if the library is of the "Subscribed" Type.
+   */
+  lastSyncTime?: string;
+  /**
+   * LastTaskInfo describes the last vCenter task that failed for this library.
+   */
+  lastTaskInfo?: ContentLibraryStatusLastTaskInfo;
+  /**
+   * Name specifies the name of the content library in vCenter.
+   */
+  name: string;
+  /**
+   * Permissions summarizes the vCenter principals that can modify the library, so that they can be audited
+   * from Kubernetes. It is refreshed periodically.
+   */
+  permissions?: ContentLibraryStatusPermissions;
+  /**
+   * ProviderCapabilities describes the content library features supported by the vCenter the library
+   * belongs to. It is refreshed when the version of the vCenter changes.
+   */
+  providerCapabilities?: ContentLibraryStatusProviderCapabilities;
+  /**
+   * Published indicates how the library is published so that it can be subscribed to by a remote subscribed library.
+   */
+  publishInfo?: ContentLibraryStatusPublishInfo;
+  /**
+   * ResyncIntervalSeconds is the effective interval, in seconds, at which the library is reconciled with
+   * vCenter, i.e. spec.resyncIntervalSeconds if set, or the default interval of the operator otherwise.
+   */
+  resyncIntervalSeconds?: number;
+  /**
+   * StorageBacking indicates the default storage backing available for this library in vCenter.
+   */
+  storageBacking: ContentLibraryStatusStorageBacking;
+  /**
+   * StoragePolicyID is the identifier of the storage policy the storage backing of the library was resolved
+   * from, either Spec.StoragePolicyID or the policy of Spec.StorageClassName.
+   */
+  storagePolicyID?: string;
+  /**
+   * SubscriptionInfo defines how the subscribed library synchronizes to a remote source.
+   * This field is populated only if the library is of the "Subscribed" type.
+   */
+  subscriptionInfo?: ContentLibraryStatusSubscriptionInfo;
+  /**
+   * Summary is a one-line human readable summary of the state of the ContentLibrary, e.g. "Ready" or
+   * "Error: publisher certificate expired", derived from its conditions by conditions.ReadyMessage.
+   */
+  summary?: string;
+  /**
+   * Type indicates the type of a library in vCenter.
+   * Possible types are "Local" and "Subscribed".
+   */
+  type: string;
+  /**
+   * UUID is the identifier of the library in vCenter, either Spec.UUID or the identifier generated when the
+   * library described by Spec.Create was created.
+   */
+  uuid?: string;
+  /**
+   * VCenterInstanceUUID is the instance UUID of the vCenter the library belongs to.
+   */
+  vCenterInstanceUUID?: string;
+  /**
+   * Version is a number that can identify metadata changes. This integer value is incremented when the library
+   * properties such as name or description are changed in vCenter.
+   */
+  version: string;
+}
+
+/**
+ * ContentLibrary is the schema for the content library API.
+ * A ContentLibrary either adopts an existing library in vCenter, identified by spec.uuid, or has the operator
+ * create a new library described by spec.create, whose UUID is then reported in status.uuid. Users manage the
+ * settings of the library through its spec, e.g. whether it is writable, its subscription and publication,
+ * and whether the library is deleted in vCenter with the ContentLibrary. Only a library created by the
+ * operator can be deleted in vCenter, and the identity and storage of a library cannot change once set.
+ */
+export interface ContentLibrary {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibrarySpec defines the desired state of a ContentLibrary.
+   * A ContentLibrary either adopts an existing library in vCenter, identified by UUID, or has the operator
+   * create a new library described by Create. Exactly one of the two fields must be set, and a ContentLibrary
+   * cannot switch between the two modes.
+   */
+  spec?: ContentLibrarySpec;
+  /**
+   * ContentLibraryStatus defines the observed state of ContentLibrary.
+   */
+  status?: ContentLibraryStatus;
+}
+
+/**
+ * Notifications defines which vCenter content library notifications are propagated to the library and
+ * library item resources as conditions and Events. If unset, notifications are not propagated.
+ */
+export interface ContentLibraryConfigurationSpecNotifications {
+  /**
+   * EmitEvents indicates whether notifications are recorded as Kubernetes Events on the affected library
+   * and library item resources, in addition to updating their conditions.
+   */
+  emitEvents?: boolean;
+  /**
+   * Enabled indicates whether vCenter content library notifications are propagated.
+   */
+  enabled: boolean;
+  /**
+   * Types are the notification types that are propagated. If empty, all the types are propagated.
+   */
+  types?: ("LibraryUpdated" | "LibraryDeleted" | "ItemCreated" | "ItemUpdated" | "ItemDeleted")[];
+}
+
+/**
+ * ContentLibraryConfigurationSpec defines the desired state of the ContentLibraryConfiguration.
+ * Unset fields fall back to the defaults of the content library operator.
+ */
+export interface ContentLibraryConfigurationSpec {
+  /**
+   * DefaultSyncInterval is the interval at which libraries are synchronized with vCenter.
+   */
+  defaultSyncInterval?: string;
+  /**
+   * FeatureGates enables or disables the named features of the content library operator. The known features
+   * are listed by DefaultFeatureGates.
+   */
+  featureGates?: { [key: string]: boolean };
+  /**
+   * ItemNamePrefix is the prefix used to derive the names of library item resources from vCenter UUIDs.
+   * Defaults to "clitem" for ContentLibraryItem resources and "cclitem" for ClusterContentLibraryItem resources.
+   */
+  itemNamePrefix?: string;
+  /**
+   * MaxConcurrentSyncs is the maximum number of libraries and library items that are synchronized concurrently.
+   */
+  maxConcurrentSyncs?: number;
+  /**
+   * MaxInFlightTransfersPerNamespace is the maximum number of ContentLibraryItemImportRequests and
+   * ContentLibraryItemFileUploads a namespace may have in flight, so that a single namespace cannot exhaust
+   * the transfer sessions of vCenter. It can be lowered, but not raised, for a namespace with the
+   * MaxInFlightTransfersAnnotationKey annotation of the namespace.
+   */
+  maxInFlightTransfersPerNamespace?: number;
+  /**
+   * Notifications defines which vCenter content library notifications are propagated to the library and
+   * library item resources as conditions and Events. If unset, notifications are not propagated.
+   */
+  notifications?: ContentLibraryConfigurationSpecNotifications;
+  /**
+   * OrphanedItemGracePeriod is how long a library item resource is kept in the "Orphaned" phase after the
+   * library item was deleted in vCenter, before the resource is deleted.
+   */
+  orphanedItemGracePeriod?: string;
+  /**
+   * StaleThreshold is how long a library or library item resource can go without being reconciled before it
+   * is marked Stale.
+   */
+  staleThreshold?: string;
+  /**
+   * VCenterSessionPoolSize is the maximum number of concurrent sessions the operator keeps open to vCenter.
+   */
+  vCenterSessionPoolSize?: number;
+}
+
+/**
+ * Notifications defines which vCenter content library notifications are propagated to the library and
+ * library item resources as conditions and Events. If unset, notifications are not propagated.
+ */
+export interface ContentLibraryConfigurationStatusAppliedConfigurationNotifications {
+  /**
+   * EmitEvents indicates whether notifications are recorded as Kubernetes Events on the affected library
+   * and library item resources, in addition to updating their conditions.
+   */
+  emitEvents?: boolean;
+  /**
+   * Enabled indicates whether vCenter content library notifications are propagated.
+   */
+  enabled: boolean;
+  /**
+   * Types are the notification types that are propagated. If empty, all the types are propagated.
+   */
+  types?: ("LibraryUpdated" | "LibraryDeleted" | "ItemCreated" | "ItemUpdated" | "ItemDeleted")[];
+}
+
+/**
+ * AppliedConfiguration is the configuration in effect in the content library operator, with all the
+ * defaults resolved.
+ */
+export interface ContentLibraryConfigurationStatusAppliedConfiguration {
+  /**
+   * DefaultSyncInterval is the interval at which libraries are synchronized with vCenter.
+   */
+  defaultSyncInterval?: string;
+  /**
+   * FeatureGates enables or disables the named features of the content library operator. The known features
+   * are listed by DefaultFeatureGates.
+   */
+  featureGates?: { [key: string]: boolean };
+  /**
+   * ItemNamePrefix is the prefix used to derive the names of library item resources from vCenter UUIDs.
+   * Defaults to "clitem" for ContentLibraryItem resources and "cclitem" for ClusterContentLibraryItem resources.
+   */
+  itemNamePrefix?: string;
+  /**
+   * MaxConcurrentSyncs is the maximum number of libraries and library items that are synchronized concurrently.
+   */
+  maxConcurrentSyncs?: number;
+  /**
+   * MaxInFlightTransfersPerNamespace is the maximum number of ContentLibraryItemImportRequests and
+   * ContentLibraryItemFileUploads a namespace may have in flight, so that a single namespace cannot exhaust
+   * the transfer sessions of vCenter. It can be lowered, but not raised, for a namespace with the
+   * MaxInFlightTransfersAnnotationKey annotation of the namespace.
+   */
+  maxInFlightTransfersPerNamespace?: number;
+  /**
+   * Notifications defines which vCenter content library notifications are propagated to the library and
+   * library item resources as conditions and Events. If unset, notifications are not propagated.
+   */
+  notifications?: ContentLibraryConfigurationStatusAppliedConfigurationNotifications;
+  /**
+   * OrphanedItemGracePeriod is how long a library item resource is kept in the "Orphaned" phase after the
+   * library item was deleted in vCenter, before the resource is deleted.
+   */
+  orphanedItemGracePeriod?: string;
+  /**
+   * StaleThreshold is how long a library or library item resource can go without being reconciled before it
+   * is marked Stale.
+   */
+  staleThreshold?: string;
+  /**
+   * VCenterSessionPoolSize is the maximum number of concurrent sessions the operator keeps open to vCenter.
+   */
+  vCenterSessionPoolSize?: number;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryConfigurationStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * ContentLibraryConfigurationStatus defines the observed state of the ContentLibraryConfiguration.
+ */
+export interface ContentLibraryConfigurationStatus {
+  /**
+   * AppliedConfiguration is the configuration in effect in the content library operator, with all the
+   * defaults resolved.
+   */
+  appliedConfiguration?: ContentLibraryConfigurationStatusAppliedConfiguration;
+  /**
+   * Conditions describes the current condition information of the ContentLibraryConfiguration.
+   */
+  conditions?: ContentLibraryConfigurationStatusConditions[];
+  /**
+   * ObservedGeneration is the generation of the ContentLibraryConfiguration that was last applied.
+   */
+  observedGeneration?: number;
+}
+
+/**
+ * ContentLibraryConfiguration is the schema for the content library configuration API.
+ * It is a cluster scoped singleton named "default" that holds the operator-wide settings of the content
+ * library operator.
+ */
+export interface ContentLibraryConfiguration {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibraryConfigurationSpec defines the desired state of the ContentLibraryConfiguration.
+   * Unset fields fall back to the defaults of the content library operator.
+   */
+  spec?: ContentLibraryConfigurationSpec;
+  /**
+   * ContentLibraryConfigurationStatus defines the observed state of the ContentLibraryConfiguration.
+   */
+  status?: ContentLibraryConfigurationStatus;
+}
+
+/**
+ * ConfigMapKeyRef selects the key of a ConfigMap in the same namespace that holds the manifest.
+ */
+export interface ContentLibraryImportSetSpecManifestConfigMapKeyRef {
+  /**
+   * The key to select.
+   */
+  key: string;
+  /**
+   * Name of the referent.
+   * More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+   */
+  name?: string;
+  /**
+   * Specify whether the ConfigMap or its key must be defined
+   */
+  optional?: boolean;
+}
+
+/**
+ * Manifest describes where the list of images to import is read from.
+ */
+export interface ContentLibraryImportSetSpecManifest {
+  /**
+   * ConfigMapKeyRef selects the key of a ConfigMap in the same namespace that holds the manifest.
+   */
+  configMapKeyRef?: ContentLibraryImportSetSpecManifestConfigMapKeyRef;
+  /**
+   * URL is the HTTP(S) endpoint that serves the manifest.
+   */
+  url?: string;
+}
+
+/**
+ * ContentLibraryImportSetSpec defines the desired state of a ContentLibraryImportSet.
+ */
+export interface ContentLibraryImportSetSpec {
+  /**
+   * ContentLibraryRef is the name of the writable ContentLibrary in the same namespace that the images are
+   * imported into.
+   */
+  contentLibraryRef: string;
+  /**
+   * Manifest describes where the list of images to import is read from.
+   */
+  manifest: ContentLibraryImportSetSpecManifest;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryImportSetStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * ImportSetEntryStatus describes the observed state of the import of a manifest entry.
+ */
+export interface ContentLibraryImportSetStatusEntries {
+  /**
+   * ImportRequestRef is the name of the ContentLibraryItemImportRequest created for the entry.
+   */
+  importRequestRef?: string;
+  /**
+   * Name is the name of the manifest entry.
+   */
+  name: string;
+  /**
+   * Phase indicates the phase of the import of the entry.
+   * Possible values are "Pending", "Running", "Succeeded" and "Failed".
+   */
+  phase?: string;
+}
+
+/**
+ * ContentLibraryImportSetStatus defines the observed state of a ContentLibraryImportSet.
+ */
+export interface ContentLibraryImportSetStatus {
+  /**
+   * Conditions describes the current condition information of the ContentLibraryImportSet.
+   */
+  conditions?: ContentLibraryImportSetStatusConditions[];
+  /**
+   * Entries describes the observed state of the import of each manifest entry.
+   */
+  entries?: ContentLibraryImportSetStatusEntries[];
+  /**
+   * Failed is the number of entries whose import failed.
+   */
+  failed?: number;
+  /**
+   * Succeeded is the number of entries that were imported successfully.
+   */
+  succeeded?: number;
+  /**
+   * Total is the number of entries in the manifest.
+   */
+  total?: number;
+}
+
+/**
+ * ContentLibraryImportSet is the schema for the content library import set API.
+ * It imports every image listed in a manifest into a writable content library, by creating and tracking a
+ * ContentLibraryItemImportRequest per image.
+ */
+export interface ContentLibraryImportSet {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibraryImportSetSpec defines the desired state of a ContentLibraryImportSet.
+   */
+  spec?: ContentLibraryImportSetSpec;
+  /**
+   * ContentLibraryImportSetStatus defines the observed state of a ContentLibraryImportSet.
+   */
+  status?: ContentLibraryImportSetStatus;
+}
+
+/**
+ * AttestationRef refers to the signatures or attestations of the content of the library item. When set,
+ * the content is verified against them and the result is reported by the SignatureVerified condition.
+ */
+export interface ContentLibraryItemSpecAttestationRef {
+  /**
+   * Reference is the location of the signatures, e.g. the OCI reference of a cosign signature
+   * "registry.example.com/images/photon:sha256-<digest>.sig".
+   */
+  reference: string;
+  /**
+   * Type is the format of the signatures.
+   * Possible values are "Cosign" and "Notary".
+   */
+  type: "Cosign" | "Notary";
+}
+
+/**
+ * ContentLibraryItemSpec defines the desired state of a ContentLibraryItem.
+ */
+export interface ContentLibraryItemSpec {
+  /**
+   * AttestationRef refers to the signatures or attestations of the content of the library item. When set,
+   * the content is verified against them and the result is reported by the SignatureVerified condition.
+   */
+  attestationRef?: ContentLibraryItemSpecAttestationRef;
+  /**
+   * CustomMetadata is the desired custom metadata of the library item in vCenter, e.g. build IDs, git
+   * commits or compliance tags. When set, the metadata of the item in vCenter is updated to match.
+   * The keys and values must not exceed CustomMetadataMaxBytes in total.
+   * This field can only be set for items of a writable ContentLibrary.
+   */
+  customMetadata?: { [key: string]: string };
+  /**
+   * Description is the desired human-readable description of the library item in vCenter. When set, the
+   * description is updated in vCenter to match, and Status.Description reports the actual description.
+   * This field can only be set for items of a writable ContentLibrary.
+   */
+  description?: string;
+  /**
+   * Name is the desired name of the library item in vCenter. When set, the library item is renamed in vCenter
+   * to match, and Status.Name reports the actual name once the rename is done.
+   * This field can only be set for items of a writable ContentLibrary.
+   */
+  name?: string;
+  /**
+   * UUID is the identifier which uniquely identifies the library item in vCenter. This field is immutable.
+   */
+  uuid: string;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryItemStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * ContentLibraryRef refers to the ContentLibrary custom resource that this item belongs to.
+ */
+export interface ContentLibraryItemStatusContentLibraryRef {
+  /**
+   * Name is the name of resource being referenced.
+   */
+  name: string;
+  /**
+   * Namespace of the resource being referenced. If empty, cluster scoped resource is assumed.
+   */
+  namespace?: string;
+}
+
+/**
+ * DeploymentDefaults describes the default values for deploying a VM from the OVF.
+ * This field is populated only if the library item is of the "Ovf" type.
+ */
+export interface ContentLibraryItemStatusDeploymentDefaults {
+  /**
+   * DeploymentOption is the default deployment option, from the DeploymentOptionSection.
+   */
+  deploymentOption?: string;
+  /**
+   * DeploymentOptions are all the deployment options available in the DeploymentOptionSection.
+   */
+  deploymentOptions?: string[];
+  /**
+   * GuestOSID is the vSphere guest OS identifier of the OperatingSystemSection, e.g. "ubuntu64Guest".
+   */
+  guestOSID?: string;
+  /**
+   * MinCPUs is the number of virtual CPUs required by the VirtualHardwareSection.
+   */
+  minCPUs?: number;
+  /**
+   * MinMemory is the amount of memory required by the VirtualHardwareSection.
+   */
+  minMemory?: number | string;
+  /**
+   * Networks are the names of the networks the OVF connects to by default, from the NetworkSection.
+   */
+  networks?: string[];
+  /**
+   * Properties are the keys of the properties of the ProductSection that can be set at deployment time,
+   * e.g. "user-data" or "hostname".
+   */
+  properties?: string[];
+}
+
+/**
+ * ConfigMapKeyRef selects the key of a ConfigMap that holds the text of a license agreement exceeding
+ * EULAMaxInlineBytes. The ConfigMap is in the namespace of a ContentLibraryItem, or in the namespace of
+ * the content library operator for a ClusterContentLibraryItem.
+ */
+export interface ContentLibraryItemStatusEulasConfigMapKeyRef {
+  /**
+   * The key to select.
+   */
+  key: string;
+  /**
+   * Name of the referent.
+   * More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+   */
+  name?: string;
+  /**
+   * Specify whether the ConfigMap or its key must be defined
+   */
+  optional?: boolean;
+}
+
+/**
+ * EULA describes a license agreement of the EulaSection of an OVF descriptor. Exactly one of Text and
+ * ConfigMapKeyRef is set.
+ */
+export interface ContentLibraryItemStatusEulas {
+  /**
+   * ConfigMapKeyRef selects the key of a ConfigMap that holds the text of a license agreement exceeding
+   * EULAMaxInlineBytes. The ConfigMap is in the namespace of a ContentLibraryItem, or in the namespace of
+   * the content library operator for a ClusterContentLibraryItem.
+   */
+  configMapKeyRef?: ContentLibraryItemStatusEulasConfigMapKeyRef;
+  /**
+   * Text is the text of the license agreement, if it does not exceed EULAMaxInlineBytes.
+   */
+  text?: string;
+}
+
+/**
+ * Checksum is the checksum of the file, as computed by vCenter.
+ */
+export interface ContentLibraryItemStatusFilesChecksum {
+  /**
+   * Algorithm is the algorithm used to calculate the checksum.
+   * Possible values are "SHA1", "SHA256", "SHA512" and "MD5".
+   */
+  algorithm: "SHA1" | "SHA256" | "SHA512" | "MD5";
+  /**
+   * Value is the hex encoded checksum of the file.
+   */
+  value: string;
+}
+
+/**
+ * FileInfo describes a file of a library item.
+ */
+export interface ContentLibraryItemStatusFiles {
+  /**
+   * Cached indicates if the file is on disk in vCenter.
+   */
+  cached: boolean;
+  /**
+   * Checksum is the checksum of the file, as computed by vCenter.
+   */
+  checksum?: ContentLibraryItemStatusFilesChecksum;
+  /**
+   * Name is the name of the file in the library item.
+   */
+  name: string;
+  /**
+   * Size is the size of the file in bytes.
+   */
+  size?: number;
+  /**
+   * Version is the version of the file. It is incremented when the content of the file is changed.
+   */
+  version?: string;
+}
+
+/**
+ * NameAndKindRef refers to an object of another API by its kind and name, e.g. a VirtualMachineImage.
+ * A namespaced object must be in the namespace of the referring resource.
+ */
+export interface ContentLibraryItemStatusImageRefs {
+  /**
+   * APIVersion is the group and version of the API of the object, e.g. "vmoperator.vmware.com/v1alpha1".
+   */
+  apiVersion?: string;
+  /**
+   * Kind is the kind of the object, e.g. "VirtualMachineImage".
+   */
+  kind: string;
+  /**
+   * Name is the name of the object.
+   */
+  name: string;
+}
+
+/**
+ * IsoInfo describes the metadata extracted from the ISO image.
+ * This field is populated only if the library item is of the "Iso" type.
+ */
+export interface ContentLibraryItemStatusIsoInfo {
+  /**
+   * Bootable indicates whether the ISO image contains a boot catalog.
+   */
+  bootable?: boolean;
+  /**
+   * OSHints are the guest OS identifiers detected from the contents of the ISO image, e.g. "ubuntu64Guest".
+   * They are best-effort and must not be relied on for security decisions.
+   */
+  osHints?: string[];
+  /**
+   * Size is the size of the ISO image in bytes.
+   */
+  size?: number;
+  /**
+   * VolumeLabel is the volume label of the ISO image.
+   */
+  volumeLabel?: string;
+}
+
+/**
+ * LastError describes the last error encountered while reconciling the library item, and whether it is retried.
+ * This field is cleared once the library item is reconciled successfully.
+ */
+export interface ContentLibraryItemStatusLastError {
+  /**
+   * Message is a human-readable description of the error.
+   */
+  message: string;
+  /**
+   * NextRetryTime indicates when the controller retries the operation next.
+   * This field is populated only if Retryable is true.
+   */
+  nextRetryTime?: string;
+  /**
+   * Operation is the operation that failed, e.g. "Sync" or "Import".
+   */
+  operation: string;
+  /**
+   * RetryCount is the number of times the operation was retried since it first failed.
+   */
+  retryCount?: number;
+  /**
+   * Retryable indicates whether the controller retries the operation automatically. If false, the error
+   * requires intervention.
+   */
+  retryable: boolean;
+  /**
+   * Time indicates when the error occurred.
+   */
+  time: string;
+}
+
+/**
+ * LastTaskInfo describes the last vCenter task that failed for this library item.
+ */
+export interface ContentLibraryItemStatusLastTaskInfo {
+  /**
+   * CompletionTime indicates the time when the task completed in vCenter.
+   */
+  completionTime?: string;
+  /**
+   * Description is a human-readable description of the task.
+   */
+  description?: string;
+  /**
+   * ErrorMessage is the error reported by vCenter for the task.
+   */
+  errorMessage?: string;
+  /**
+   * ID is the managed object reference of the vCenter task, e.g. "task-1234".
+   */
+  id: string;
+  /**
+   * OperationID is the vCenter operation ID (opID) of the request that started the task.
+   */
+  operationID?: string;
+  /**
+   * QueueTime indicates the time when the task was queued in vCenter.
+   */
+  queueTime?: string;
+  /**
+   * StartTime indicates the time when the task was started in vCenter.
+   */
+  startTime?: string;
+}
+
+/**
+ * PublisherInfo identifies the published library the item was synchronized from.
+ * This field is populated only if SourceLibraryType is "Subscribed".
+ */
+export interface ContentLibraryItemStatusPublisherInfo {
+  /**
+   * LibraryName is the name of the published library in the publishing vCenter, if known.
+   */
+  libraryName?: string;
+  /**
+   * LibraryUUID is the identifier of the published library in the publishing vCenter, if known.
+   */
+  libraryUUID?: string;
+  /**
+   * PublicationURLHost is the host, and port if any, of the subscription URL of the subscribed library.
+   */
+  publicationURLHost?: string;
+  /**
+   * VCenterInstanceUUID is the instance UUID of the publishing vCenter, if known.
+   */
+  vCenterInstanceUUID?: string;
+}
+
+/**
+ * ConfigMapKeyRef selects the key of a ConfigMap that holds the software bill of materials. The ConfigMap
+ * is in the namespace of a ContentLibraryItem, or in the namespace of the content library operator for a
+ * ClusterContentLibraryItem.
+ */
+export interface ContentLibraryItemStatusSbomRefConfigMapKeyRef {
+  /**
+   * The key to select.
+   */
+  key: string;
+  /**
+   * Name of the referent.
+   * More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+   */
+  name?: string;
+  /**
+   * Specify whether the ConfigMap or its key must be defined
+   */
+  optional?: boolean;
+}
+
+/**
+ * SecretKeyRef selects the key of a Secret that holds the software bill of materials. The Secret is in the
+ * same namespace as the ConfigMap of ConfigMapKeyRef would be.
+ */
+export interface ContentLibraryItemStatusSbomRefSecretKeyRef {
+  /**
+   * The key of the secret to select from.  Must be a valid secret key.
+   */
+  key: string;
+  /**
+   * Name of the referent.
+   * More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+   */
+  name?: string;
+  /**
+   * Specify whether the Secret or its key must be defined
+   */
+  optional?: boolean;
+}
+
+/**
+ * SBOMRef refers to the software bill of materials of the current content of the library item, attached
+ * by the pipeline that built the image.
+ */
+export interface ContentLibraryItemStatusSbomRef {
+  /**
+   * ConfigMapKeyRef selects the key of a ConfigMap that holds the software bill of materials. The ConfigMap
+   * is in the namespace of a ContentLibraryItem, or in the namespace of the content library operator for a
+   * ClusterContentLibraryItem.
+   */
+  configMapKeyRef?: ContentLibraryItemStatusSbomRefConfigMapKeyRef;
+  /**
+   * Format is the format of the software bill of materials.
+   * Possible values are "SPDX" and "CycloneDX".
+   */
+  format: "SPDX" | "CycloneDX";
+  /**
+   * SecretKeyRef selects the key of a Secret that holds the software bill of materials. The Secret is in the
+   * same namespace as the ConfigMap of ConfigMapKeyRef would be.
+   */
+  secretKeyRef?: ContentLibraryItemStatusSbomRefSecretKeyRef;
+  /**
+   * URL is the HTTP(S) endpoint that serves the software bill of materials.
+   */
+  url?: string;
+}
+
+/**
+ * SignatureInfo describes a signature of the content of a library item.
+ */
+export interface ContentLibraryItemStatusSignatures {
+  /**
+   * Digest is the digest of the signed content, e.g. "sha256:<hex>".
+   */
+  digest?: string;
+  /**
+   * Message describes why the signature could not be verified.
+   */
+  message?: string;
+  /**
+   * SignedTime indicates the time when the content was signed, if known.
+   */
+  signedTime?: string;
+  /**
+   * Signer identifies the signer, e.g. the subject of the signing certificate or the ID of the signing key.
+   */
+  signer: string;
+  /**
+   * Verified indicates whether the signature was verified against the content of the library item.
+   */
+  verified: boolean;
+}
+
+/**
+ * SourceItemRef refers to the library item this library item was copied from, if it was copied from
+ * another library.
+ */
+export interface ContentLibraryItemStatusSourceItemRef {
+  /**
+   * Kind is the kind of the source library item.
+   * Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+   */
+  kind: "ContentLibraryItem" | "ClusterContentLibraryItem";
+  /**
+   * Name is the name of the source library item resource.
+   */
+  name: string;
+  /**
+   * Namespace is the namespace of the source library item. It is empty for a ClusterContentLibraryItem.
+   */
+  namespace?: string;
+  /**
+   * UUID is the identifier of the source library item in vCenter, which remains valid after the source
+   * library item resource is deleted.
+   */
+  uuid?: string;
+}
+
+/**
+ * SyncProgress describes the progress of the ongoing transfer of the library item content.
+ * This field is populated only while the Transferring condition is true.
+ */
+export interface ContentLibraryItemStatusSyncProgress {
+  /**
+   * BytesTransferred is the number of bytes that have been transferred so far.
+   */
+  bytesTransferred?: number;
+  /**
+   * EstimatedCompletionTime is the estimated time at which the transfer completes.
+   */
+  estimatedCompletionTime?: string;
+  /**
+   * Percentage is the completion percentage of the transfer.
+   */
+  percentage?: number;
+  /**
+   * TotalBytes is the total number of bytes to transfer, if known.
+   */
+  totalBytes?: number;
+}
+
+/**
+ * ContentLibraryItemStatus defines the observed state of ContentLibraryItem.
+ */
+export interface ContentLibraryItemStatus {
+  /**
+   * CacheMessage is a human readable message with details about the CacheStatus, e.g. the error that
+   * caused caching to fail.
+   */
+  cacheMessage?: string;
+  /**
+   * CacheReason is a brief CamelCase reason for the CacheStatus, e.g. "NotRequested" or "CachingFailed".
+   */
+  cacheReason?: string;
+  /**
+   * CacheStatus indicates whether the library item files are on disk in vCenter. It supersedes Cached,
+   * which is true only if CacheStatus is "Cached".
+   * Possible values are "Cached", "NotCached", "Caching", "Evicting" and "Error".
+   */
+  cacheStatus?: "Cached" | "NotCached" | "Caching" | "Evicting" | "Error";
+  /**
+   * Cached indicates if the library item files are on disk in vCenter.
+   * 
+   * Deprecated: Use CacheStatus, which also reports why the library item files are not on disk.
+   */
+  cached: boolean;
+  /**
+   * CloneGeneration is the number of copies between the original library item and this library item, e.g.
+   * 2 for the namespace copy of a regional copy of a golden image. It is 0 for library items that were not
+   * copied.
+   */
+  cloneGeneration?: number;
+  /**
+   * Conditions describes the current condition information of the ContentLibraryItem.
+   */
+  conditions?: ContentLibraryItemStatusConditions[];
+  /**
+   * ContentLibraryRef refers to the ContentLibrary custom resource that this item belongs to.
+   */
+  contentLibraryRef: ContentLibraryItemStatusContentLibraryRef;
+  /**
+   * ContentVersion indicates the version of the library item content.
+   * This integer value is incremented when the files comprising the content library item are changed in vCenter.
+   */
+  contentVersion: string;
+  /**
+   * CreationTime indicates the date and time when this library item was created.
+   */
+  creationTime: string;
+  /**
+   * CustomMetadata is the custom metadata of the library item in vCenter.
+   */
+  customMetadata?: { [key: string]: string };
+  /**
+   * DeployCount is the number of VMs deployed from the library item. It is incremented by the consumers of
+   * the library item, e.g. vm-operator, with RecordDeploy, so that unused images can be identified.
+   */
+  deployCount?: number;
+  /**
+   * DeploymentDefaults describes the default values for deploying a VM from the OVF.
+   * This field is populated only if the library item is of the "Ovf" type.
+   */
+  deploymentDefaults?: ContentLibraryItemStatusDeploymentDefaults;
+  /**
+   * Description is a human-readable description for this library item.
+   */
+  description?: string;
+  /**
+   * EULAs are the license agreements of the EulaSection of the OVF descriptor, which must be presented to
+   * and accepted by users deploying VMs from the library item.
+   * This field is populated only if the library item is of the "Ovf" type.
+   */
+  eulas?: ContentLibraryItemStatusEulas[];
+  /**
+   * Files describes the files of the library item.
+   */
+  files?: ContentLibraryItemStatusFiles[];
+  /**
+   * HardwareVersion is the virtual hardware version of the VMs described by the OVF, e.g. 19 for "vmx-19".
+   * This field is populated only if the library item is of the "Ovf" type.
+   */
+  hardwareVersion?: number;
+  /**
+   * ImageRefs refers to the VirtualMachineImage resources generated from the library item, so that the image
+   * of a library item can be found without searching by UUID.
+   */
+  imageRefs?: ContentLibraryItemStatusImageRefs[];
+  /**
+   * IsoInfo describes the metadata extracted from the ISO image.
+   * This field is populated only if the library item is of the "Iso" type.
+   */
+  isoInfo?: ContentLibraryItemStatusIsoInfo;
+  /**
+   * LastDeployTime indicates the time when a VM was last deployed from the library item. It is set by the
+   * consumers of the library item together with DeployCount.
+   */
+  lastDeployTime?: string;
+  /**
+   * LastDriftCheckTime indicates the time when the library item was last compared with vCenter to detect
+   * out-of-band changes.
+   */
+  lastDriftCheckTime?: string;
+  /**
+   * LastError describes the last error encountered while reconciling the library item, and whether it is retried.
+   * This field is cleared once the library item is reconciled successfully.
+   */
+  lastError?: ContentLibraryItemStatusLastError;
+  /**
+   * LastModifiedTime indicates the date and time when this library item was last updated.
+   * This field is updated when the library item properties are changed or the file content is changed.
+   */
+  lastModifiedTime: string;
+  /**
+   * LastObservedTime indicates the time when the controller last reconciled the library item. It is updated on
+   * every reconciliation, even when nothing changed, so that a wedged controller can be detected.
+   */
+  lastObservedTime?: string;
+  /**
+   * LastSyncTime indicates the date and time when this library item was last synchronized.
+   * This field applies only to subscribed library items.
+   */
+  lastSyncTime?: string;
+  /**
+   * LastTaskInfo describes the last vCenter task that failed for this library item.
+   */
+  lastTaskInfo?: ContentLibraryItemStatusLastTaskInfo;
+  /**
+   * LastTransferThroughput is the average rate, in bytes per second, of the last completed transfer of the library item content.
+   */
+  lastTransferThroughput?: number;
+  /**
+   * LastUsedTime indicates the time when the content of the library item was last used, e.g. to deploy
+   * a VM. It drives the eviction of the cached content of the item.
+   */
+  lastUsedTime?: string;
+  /**
+   * MetadataVersion indicates the version of the library item metadata.
+   * This integer value is incremented when the library item properties such as name or description are changed in vCenter.
+   */
+  metadataVersion: string;
+  /**
+   * MinSupportedHWVersion is the highest virtual hardware version supported by all the target clusters,
+   * i.e. the lowest of the highest hardware versions the clusters support. The HardwareVersionSupported
+   * condition is false if HardwareVersion exceeds it.
+   * This field is populated only if the library item is of the "Ovf" type.
+   */
+  minSupportedHWVersion?: number;
+  /**
+   * Name specifies the name of the content library item in vCenter specified by the user.
+   */
+  name: string;
+  /**
+   * OCIRef is the OCI-style reference of the current content of the library item, of the form
+   * "registry/<library-uuid>/<item-uuid>:v<content-version>@<digest>", so that OCI signing, SBOM and policy
+   * tools can refer to it. This field is populated only if the operator is configured with a registry.
+   */
+  ociRef?: string;
+  /**
+   * Phase indicates the lifecycle phase of the library item.
+   * Possible values are "Available" and "Orphaned".
+   */
+  phase?: string;
+  /**
+   * PublisherInfo identifies the published library the item was synchronized from.
+   * This field is populated only if SourceLibraryType is "Subscribed".
+   */
+  publisherInfo?: ContentLibraryItemStatusPublisherInfo;
+  /**
+   * RawType is the type of the library item as reported by vCenter, e.g. "ovf" or "iso". It is recorded for
+   * every item, and in particular explains the items of the "Unknown" type.
+   */
+  rawType?: string;
+  /**
+   * Ready denotes that the library item is ready to be used.
+   */
+  ready: boolean;
+  /**
+   * SBOMRef refers to the software bill of materials of the current content of the library item, attached
+   * by the pipeline that built the image.
+   */
+  sbomRef?: ContentLibraryItemStatusSbomRef;
+  /**
+   * Signatures describes the signatures found at Spec.AttestationRef.
+   */
+  signatures?: ContentLibraryItemStatusSignatures[];
+  /**
+   * Size indicates the library item size in bytes
+   */
+  size?: number;
+  /**
+   * SourceItemRef refers to the library item this library item was copied from, if it was copied from
+   * another library.
+   */
+  sourceItemRef?: ContentLibraryItemStatusSourceItemRef;
+  /**
+   * SourceLibraryType indicates the type of the ContentLibrary this item belongs to.
+   * Possible types are "Local" and "Subscribed".
+   */
+  sourceLibraryType?: string;
+  /**
+   * StorageURIs lists the datastore URIs of the library item files, e.g.
+   * "ds:///vmfs/volumes/<datastore-uuid>/contentlib-<library-uuid>/<item-uuid>/disk-0.vmdk".
+   * This field is populated only when the library item files are cached.
+   */
+  storageURIs?: string[];
+  /**
+   * Summary is a one-line human readable summary of the state of the ContentLibraryItem, e.g. "Ready" or
+   * "Error: publisher certificate expired", derived from its conditions by conditions.ReadyMessage.
+   */
+  summary?: string;
+  /**
+   * SyncProgress describes the progress of the ongoing transfer of the library item content.
+   * This field is populated only while the Transferring condition is true.
+   */
+  syncProgress?: ContentLibraryItemStatusSyncProgress;
+  /**
+   * Type string indicates the type of the library item in vCenter.
+   * Possible types are "Ovf", "Iso", "File" and "Unknown". Items of a type that is not supported by this API
+   * have the "Unknown" type, and their TypeSupported condition is false.
+   */
+  type: string;
+}
+
+/**
+ * ContentLibraryItem is the schema for the content library item API.
+ */
+export interface ContentLibraryItem {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibraryItemSpec defines the desired state of a ContentLibraryItem.
+   */
+  spec?: ContentLibraryItemSpec;
+  /**
+   * ContentLibraryItemStatus defines the observed state of ContentLibraryItem.
+   */
+  status?: ContentLibraryItemStatus;
+}
+
+/**
+ * Checksum is the expected checksum of the file. If specified, the file is validated against it after the transfer.
+ */
+export interface ContentLibraryItemFileUploadSpecFilesChecksum {
+  /**
+   * Algorithm is the algorithm used to calculate the checksum.
+   * Possible values are "SHA1", "SHA256", "SHA512" and "MD5".
+   */
+  algorithm: "SHA1" | "SHA256" | "SHA512" | "MD5";
+  /**
+   * Value is the hex encoded checksum of the file.
+   */
+  value: string;
+}
+
+/**
+ * HTTP specifies that the file content is pulled from an HTTP(S) endpoint.
+ */
+export interface ContentLibraryItemFileUploadSpecFilesSourceHttp {
+  /**
+   * SSLCertificate is the PEM encoded certificate of the HTTPS endpoint, used when the endpoint
+   * presents a certificate that is not trusted by vCenter.
+   */
+  sslCertificate?: string;
+  /**
+   * URL is the HTTP(S) endpoint from which the file content is pulled.
+   */
+  url: string;
+}
+
+/**
+ * PersistentVolumeClaim specifies that the file content is read from a PersistentVolumeClaim.
+ */
+export interface ContentLibraryItemFileUploadSpecFilesSourcePersistentVolumeClaim {
+  /**
+   * ClaimName is the name of the PersistentVolumeClaim in the same namespace as the upload.
+   */
+  claimName: string;
+  /**
+   * Path is the path of the file relative to the root of the volume.
+   */
+  path: string;
+}
+
+/**
+ * Source describes where the content of the file comes from.
+ */
+export interface ContentLibraryItemFileUploadSpecFilesSource {
+  /**
+   * HTTP specifies that the file content is pulled from an HTTP(S) endpoint.
+   */
+  http?: ContentLibraryItemFileUploadSpecFilesSourceHttp;
+  /**
+   * Inline specifies the file content directly. Inline content is intended for small files, such as
+   * manifests or certificates, and is limited to 256KiB.
+   */
+  inline?: string;
+  /**
+   * PersistentVolumeClaim specifies that the file content is read from a PersistentVolumeClaim.
+   */
+  persistentVolumeClaim?: ContentLibraryItemFileUploadSpecFilesSourcePersistentVolumeClaim;
+}
+
+/**
+ * FileUpload describes a single file that is uploaded into a library item.
+ */
+export interface ContentLibraryItemFileUploadSpecFiles {
+  /**
+   * Checksum is the expected checksum of the file. If specified, the file is validated against it after the transfer.
+   */
+  checksum?: ContentLibraryItemFileUploadSpecFilesChecksum;
+  /**
+   * Name is the name of the file in the library item.
+   */
+  name: string;
+  /**
+   * Source describes where the content of the file comes from.
+   */
+  source: ContentLibraryItemFileUploadSpecFilesSource;
+}
+
+/**
+ * ContentLibraryItemFileUploadSpec defines the desired state of a ContentLibraryItemFileUpload.
+ */
+export interface ContentLibraryItemFileUploadSpec {
+  /**
+   * ContentLibraryItemRef is the name of the ContentLibraryItem in the same namespace that the files are
+   * uploaded into. The item must belong to a writable ContentLibrary. This field is immutable.
+   */
+  contentLibraryItemRef: string;
+  /**
+   * Files is the list of files to upload into the library item. At most 100 files can be uploaded at once.
+   */
+  files: ContentLibraryItemFileUploadSpecFiles[];
+  /**
+   * TransferRateLimit is the maximum rate, in bytes per second, at which the files are transferred, e.g.
+   * "100Mi". If unset, the transfer is not limited.
+   */
+  transferRateLimit?: number | string;
+  /**
+   * TTLSecondsAfterFinished is the number of seconds after which the upload is deleted once it completed,
+   * so that completed uploads do not accumulate. 0 deletes the upload as soon as it completed.
+   * Defaults to DefaultTTLSecondsAfterFinished.
+   */
+  ttlSecondsAfterFinished?: number;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryItemFileUploadStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * FileUploadStatus describes the observed state of the transfer of a single file into a library item, either
+ * uploaded by a ContentLibraryItemFileUpload or imported by a ContentLibraryItemImportRequest.
+ */
+export interface ContentLibraryItemFileUploadStatusFiles {
+  /**
+   * BytesTransferred is the number of bytes that have been transferred so far.
+   */
+  bytesTransferred?: number;
+  /**
+   * ChecksumVerified indicates whether the file was validated against the expected checksum.
+   */
+  checksumVerified?: boolean;
+  /**
+   * ErrorMessage describes why the transfer of the file failed.
+   */
+  errorMessage?: string;
+  /**
+   * Name is the name of the file in the library item.
+   */
+  name: string;
+  /**
+   * Size is the total size of the file in bytes, if known.
+   */
+  size?: number;
+  /**
+   * Status indicates the transfer state of the file.
+   * Possible values are "Waiting", "Transferring", "Validating", "Ready" and "Error".
+   */
+  status: string;
+}
+
+/**
+ * ContentLibraryItemFileUploadStatus defines the observed state of a ContentLibraryItemFileUpload.
+ */
+export interface ContentLibraryItemFileUploadStatus {
+  /**
+   * CompletionTime indicates the time when all the files were uploaded and validated, or when the upload
+   * failed.
+   */
+  completionTime?: string;
+  /**
+   * Conditions describes the current condition information of the ContentLibraryItemFileUpload.
+   */
+  conditions?: ContentLibraryItemFileUploadStatusConditions[];
+  /**
+   * ExpiryTime indicates the time after which the upload is deleted, i.e. CompletionTime plus
+   * Spec.TTLSecondsAfterFinished. It is set once the upload completed.
+   */
+  expiryTime?: string;
+  /**
+   * Files describes the observed state of each uploaded file.
+   */
+  files?: ContentLibraryItemFileUploadStatusFiles[];
+  /**
+   * LastTransferThroughput is the average rate, in bytes per second, of the transfer of the files.
+   */
+  lastTransferThroughput?: number;
+  /**
+   * SessionExpirationTime indicates the time after which the update session expires in vCenter if no
+   * further progress is made.
+   */
+  sessionExpirationTime?: string;
+  /**
+   * SessionUUID is the identifier of the vCenter update session used to upload the files.
+   */
+  sessionUUID?: string;
+}
+
+/**
+ * ContentLibraryItemFileUpload is the schema for the content library item file upload API.
+ * It uploads one or more files into an existing item of a writable content library.
+ */
+export interface ContentLibraryItemFileUpload {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibraryItemFileUploadSpec defines the desired state of a ContentLibraryItemFileUpload.
+   */
+  spec?: ContentLibraryItemFileUploadSpec;
+  /**
+   * ContentLibraryItemFileUploadStatus defines the observed state of a ContentLibraryItemFileUpload.
+   */
+  status?: ContentLibraryItemFileUploadStatus;
+}
+
+/**
+ * Checksum is the expected checksum of the file at URL. If specified, the file is validated against it
+ * after the transfer.
+ */
+export interface ContentLibraryItemImportRequestSpecSourceChecksum {
+  /**
+   * Algorithm is the algorithm used to calculate the checksum.
+   * Possible values are "SHA1", "SHA256", "SHA512" and "MD5".
+   */
+  algorithm: "SHA1" | "SHA256" | "SHA512" | "MD5";
+  /**
+   * Value is the hex encoded checksum of the file.
+   */
+  value: string;
+}
+
+/**
+ * Source describes the source of the image to import.
+ */
+export interface ContentLibraryItemImportRequestSpecSource {
+  /**
+   * Checksum is the expected checksum of the file at URL. If specified, the file is validated against it
+   * after the transfer.
+   */
+  checksum?: ContentLibraryItemImportRequestSpecSourceChecksum;
+  /**
+   * SSLCertificate is the PEM encoded certificate of the HTTPS endpoint, used when the endpoint
+   * presents a certificate that is not trusted by vCenter.
+   */
+  sslCertificate?: string;
+  /**
+   * URL is the HTTP(S) endpoint of the OVF descriptor, OVA or ISO image to import.
+   */
+  url: string;
+}
+
+/**
+ * Target describes the library item the image is imported into.
+ */
+export interface ContentLibraryItemImportRequestSpecTarget {
+  /**
+   * ClusterContentLibraryRef is the name of the writable ClusterContentLibrary that the image is imported
+   * into. The namespace of the ContentLibraryItemImportRequest must be selected by the allowedNamespaces of
+   * the ClusterContentLibrary.
+   */
+  clusterContentLibraryRef?: string;
+  /**
+   * ContentLibraryRef is the name of the writable ContentLibrary in the same namespace that the image is
+   * imported into.
+   */
+  contentLibraryRef?: string;
+  /**
+   * ItemDescription is the description of the library item created in vCenter.
+   */
+  itemDescription?: string;
+  /**
+   * ItemName is the name of the library item created in vCenter.
+   */
+  itemName: string;
+  /**
+   * ItemType is the type of the library item created in vCenter.
+   * Possible types are "Ovf", "Iso" and "File".
+   */
+  itemType: "Ovf" | "Iso" | "File";
+}
+
+/**
+ * ContentLibraryItemImportRequestSpec defines the desired state of a ContentLibraryItemImportRequest.
+ */
+export interface ContentLibraryItemImportRequestSpec {
+  /**
+   * Source describes the source of the image to import.
+   */
+  source: ContentLibraryItemImportRequestSpecSource;
+  /**
+   * Target describes the library item the image is imported into.
+   */
+  target: ContentLibraryItemImportRequestSpecTarget;
+  /**
+   * TransferRateLimit is the maximum rate, in bytes per second, at which the image is transferred, e.g.
+   * "100Mi". If unset, the transfer is not limited.
+   */
+  transferRateLimit?: number | string;
+  /**
+   * TTLSecondsAfterFinished is the number of seconds after which the request is deleted once the import
+   * completed, so that completed requests do not accumulate. 0 deletes the request as soon as it completed.
+   * Defaults to DefaultTTLSecondsAfterFinished.
+   */
+  ttlSecondsAfterFinished?: number;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryItemImportRequestStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * FileUploadStatus describes the observed state of the transfer of a single file into a library item, either
+ * uploaded by a ContentLibraryItemFileUpload or imported by a ContentLibraryItemImportRequest.
+ */
+export interface ContentLibraryItemImportRequestStatusFiles {
+  /**
+   * BytesTransferred is the number of bytes that have been transferred so far.
+   */
+  bytesTransferred?: number;
+  /**
+   * ChecksumVerified indicates whether the file was validated against the expected checksum.
+   */
+  checksumVerified?: boolean;
+  /**
+   * ErrorMessage describes why the transfer of the file failed.
+   */
+  errorMessage?: string;
+  /**
+   * Name is the name of the file in the library item.
+   */
+  name: string;
+  /**
+   * Size is the total size of the file in bytes, if known.
+   */
+  size?: number;
+  /**
+   * Status indicates the transfer state of the file.
+   * Possible values are "Waiting", "Transferring", "Validating", "Ready" and "Error".
+   */
+  status: string;
+}
+
+/**
+ * ContentLibraryItemImportRequestStatus defines the observed state of a ContentLibraryItemImportRequest.
+ */
+export interface ContentLibraryItemImportRequestStatus {
+  /**
+   * CompletionTime indicates the time when the import succeeded or failed.
+   */
+  completionTime?: string;
+  /**
+   * Conditions describes the current condition information of the ContentLibraryItemImportRequest.
+   */
+  conditions?: ContentLibraryItemImportRequestStatusConditions[];
+  /**
+   * ContentLibraryItemRef is the name of the ContentLibraryItem, or of the ClusterContentLibraryItem if the
+   * image is imported into a ClusterContentLibrary, created for the imported image.
+   */
+  contentLibraryItemRef?: string;
+  /**
+   * ExpiryTime indicates the time after which the request is deleted, i.e. CompletionTime plus
+   * Spec.TTLSecondsAfterFinished. It is set once the import completed.
+   */
+  expiryTime?: string;
+  /**
+   * Files describes the progress of the transfer of each file of the imported image, e.g. the descriptor
+   * and every disk of an OVF template.
+   */
+  files?: ContentLibraryItemImportRequestStatusFiles[];
+  /**
+   * LastTransferThroughput is the average rate, in bytes per second, of the last completed transfer of the image.
+   */
+  lastTransferThroughput?: number;
+  /**
+   * Phase indicates the phase of the import.
+   * Possible values are "Pending", "Running", "Succeeded" and "Failed".
+   */
+  phase?: string;
+  /**
+   * StartTime indicates the time when the import was started.
+   */
+  startTime?: string;
+}
+
+/**
+ * ContentLibraryItemImportRequest is the schema for the content library item import request API.
+ * It imports an image from an HTTP(S) endpoint into a new item of a writable content library.
+ */
+export interface ContentLibraryItemImportRequest {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibraryItemImportRequestSpec defines the desired state of a ContentLibraryItemImportRequest.
+   */
+  spec?: ContentLibraryItemImportRequestSpec;
+  /**
+   * ContentLibraryItemImportRequestStatus defines the observed state of a ContentLibraryItemImportRequest.
+   */
+  status?: ContentLibraryItemImportRequestStatus;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryItemSummaryStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * ItemDigest is a compact description of a library item, used by consumers that do not need the full
+ * library item resource.
+ */
+export interface ContentLibraryItemSummaryStatusItems {
+  /**
+   * ContentVersion indicates the version of the library item content.
+   */
+  contentVersion?: string;
+  /**
+   * Name is the name of the library item resource.
+   */
+  name: string;
+  /**
+   * Ready denotes that the library item is ready to be used.
+   */
+  ready: boolean;
+  /**
+   * UUID is the identifier of the library item in vCenter.
+   */
+  uuid: string;
+}
+
+/**
+ * ItemSummaryStatus defines the observed state of the library items of a library.
+ */
+export interface ContentLibraryItemSummaryStatus {
+  /**
+   * Conditions describes the current condition information of the summary. Ready is True once the summary
+   * reflects every library item of the library.
+   */
+  conditions?: ContentLibraryItemSummaryStatusConditions[];
+  /**
+   * Items is the digest of every library item in the library, sorted by name. At most 10000 library items
+   * are listed, which keeps the resource within the size limit of the API server.
+   */
+  items?: ContentLibraryItemSummaryStatusItems[];
+  /**
+   * LastUpdateTime indicates the time when the summary was last updated.
+   */
+  lastUpdateTime?: string;
+  /**
+   * ReadyItems is the number of library items in the library that are ready to be used.
+   */
+  readyItems?: number;
+  /**
+   * TotalItems is the number of library items in the library.
+   */
+  totalItems?: number;
+}
+
+/**
+ * ContentLibraryItemSummary is the schema for the content library item summary API.
+ * The content library operator maintains one ContentLibraryItemSummary per ContentLibrary, with the same name
+ * and namespace as the library, so lightweight consumers can watch a single object instead of every
+ * ContentLibraryItem of a large library.
+ */
+export interface ContentLibraryItemSummary {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ItemSummaryStatus defines the observed state of the library items of a library.
+   */
+  status?: ContentLibraryItemSummaryStatus;
+}
+
+/**
+ * ItemRef refers to the library item to validate. This field is immutable.
+ */
+export interface ContentLibraryItemValidationRequestSpecItemRef {
+  /**
+   * Kind is the kind of the library item.
+   * Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+   */
+  kind: "ContentLibraryItem" | "ClusterContentLibraryItem";
+  /**
+   * Name is the name of the library item. A ContentLibraryItem must be in the namespace of the referring
+   * resource.
+   */
+  name: string;
+}
+
+/**
+ * ContentLibraryItemValidationRequestSpec defines the desired state of a ContentLibraryItemValidationRequest.
+ */
+export interface ContentLibraryItemValidationRequestSpec {
+  /**
+   * ItemRef refers to the library item to validate. This field is immutable.
+   */
+  itemRef: ContentLibraryItemValidationRequestSpecItemRef;
+  /**
+   * TTLSecondsAfterFinished is the number of seconds after which the request is deleted once the validation
+   * completed, so that completed requests do not accumulate. 0 deletes the request as soon as it completed.
+   * Defaults to DefaultTTLSecondsAfterFinished.
+   */
+  ttlSecondsAfterFinished?: number;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryItemValidationRequestStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * ValidationFinding describes an issue found while validating a library item.
+ */
+export interface ContentLibraryItemValidationRequestStatusFindings {
+  /**
+   * Code is a CamelCase identifier of the check that produced the finding, e.g. "InvalidDescriptor".
+   */
+  code: string;
+  /**
+   * Location identifies where the finding was found, e.g. the OVF section or the file name.
+   */
+  location?: string;
+  /**
+   * Message is a human-readable description of the finding.
+   */
+  message: string;
+  /**
+   * Severity is the severity of the finding.
+   * Possible values are "Error" and "Warning".
+   */
+  severity: string;
+}
+
+/**
+ * ContentLibraryItemValidationRequestStatus defines the observed state of a ContentLibraryItemValidationRequest.
+ */
+export interface ContentLibraryItemValidationRequestStatus {
+  /**
+   * CompletionTime indicates the time when the validation completed.
+   */
+  completionTime?: string;
+  /**
+   * Conditions describes the current condition information of the ContentLibraryItemValidationRequest.
+   */
+  conditions?: ContentLibraryItemValidationRequestStatusConditions[];
+  /**
+   * ContentVersion is the content version of the library item that was validated.
+   */
+  contentVersion?: string;
+  /**
+   * Errors is the number of findings with the "Error" severity.
+   */
+  errors?: number;
+  /**
+   * ExpiryTime indicates the time after which the request is deleted, i.e. CompletionTime plus
+   * Spec.TTLSecondsAfterFinished. It is set once the validation completed.
+   */
+  expiryTime?: string;
+  /**
+   * Findings are the issues found in the library item, errors first.
+   */
+  findings?: ContentLibraryItemValidationRequestStatusFindings[];
+  /**
+   * UnsupportedSections are the OVF sections of the descriptor that are not supported when deploying VMs,
+   * e.g. "DeploymentOptionSection".
+   */
+  unsupportedSections?: string[];
+  /**
+   * Warnings is the number of findings with the "Warning" severity.
+   */
+  warnings?: number;
+}
+
+/**
+ * ContentLibraryItemValidationRequest is the schema for the content library item validation request API.
+ * It runs pre-flight checks, such as OVF schema validation and descriptor lint checks, against a library item
+ * and reports the findings, so that bad templates are flagged before VMs are deployed from them.
+ */
+export interface ContentLibraryItemValidationRequest {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibraryItemValidationRequestSpec defines the desired state of a ContentLibraryItemValidationRequest.
+   */
+  spec?: ContentLibraryItemValidationRequestSpec;
+  /**
+   * ContentLibraryItemValidationRequestStatus defines the observed state of a ContentLibraryItemValidationRequest.
+   */
+  status?: ContentLibraryItemValidationRequestStatus;
+}
+
+/**
+ * ContentLibraryItemVersionSpec identifies the content version of a ContentLibraryItem. It is immutable.
+ */
+export interface ContentLibraryItemVersionSpec {
+  /**
+   * ContentLibraryItemRef is the name of the ContentLibraryItem in the same namespace this is a version of.
+   */
+  contentLibraryItemRef: string;
+  /**
+   * ContentVersion is the content version of the library item, as reported by the Status.ContentVersion of
+   * the ContentLibraryItem.
+   */
+  contentVersion: string;
+}
+
+/**
+ * NewChecksum is the checksum of the file at this content version. It is unset for removed files.
+ */
+export interface ContentLibraryItemVersionStatusChangesNewChecksum {
+  /**
+   * Algorithm is the algorithm used to calculate the checksum.
+   * Possible values are "SHA1", "SHA256", "SHA512" and "MD5".
+   */
+  algorithm: "SHA1" | "SHA256" | "SHA512" | "MD5";
+  /**
+   * Value is the hex encoded checksum of the file.
+   */
+  value: string;
+}
+
+/**
+ * OldChecksum is the checksum of the file at the previous content version. It is unset for added files.
+ */
+export interface ContentLibraryItemVersionStatusChangesOldChecksum {
+  /**
+   * Algorithm is the algorithm used to calculate the checksum.
+   * Possible values are "SHA1", "SHA256", "SHA512" and "MD5".
+   */
+  algorithm: "SHA1" | "SHA256" | "SHA512" | "MD5";
+  /**
+   * Value is the hex encoded checksum of the file.
+   */
+  value: string;
+}
+
+/**
+ * FileChange describes how a file of a library item changed between two content versions.
+ */
+export interface ContentLibraryItemVersionStatusChanges {
+  /**
+   * Name is the name of the file in the library item.
+   */
+  name: string;
+  /**
+   * NewChecksum is the checksum of the file at this content version. It is unset for removed files.
+   */
+  newChecksum?: ContentLibraryItemVersionStatusChangesNewChecksum;
+  /**
+   * NewSize is the size of the file in bytes at this content version.
+   */
+  newSize?: number;
+  /**
+   * OldChecksum is the checksum of the file at the previous content version. It is unset for added files.
+   */
+  oldChecksum?: ContentLibraryItemVersionStatusChangesOldChecksum;
+  /**
+   * OldSize is the size of the file in bytes at the previous content version.
+   */
+  oldSize?: number;
+  /**
+   * Type indicates how the file changed.
+   * Possible values are "Added", "Removed" and "Changed".
+   */
+  type: "Added" | "Removed" | "Changed";
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryItemVersionStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * Checksum is the checksum of the file, as computed by vCenter.
+ */
+export interface ContentLibraryItemVersionStatusFilesChecksum {
+  /**
+   * Algorithm is the algorithm used to calculate the checksum.
+   * Possible values are "SHA1", "SHA256", "SHA512" and "MD5".
+   */
+  algorithm: "SHA1" | "SHA256" | "SHA512" | "MD5";
+  /**
+   * Value is the hex encoded checksum of the file.
+   */
+  value: string;
+}
+
+/**
+ * FileInfo describes a file of a library item.
+ */
+export interface ContentLibraryItemVersionStatusFiles {
+  /**
+   * Cached indicates if the file is on disk in vCenter.
+   */
+  cached: boolean;
+  /**
+   * Checksum is the checksum of the file, as computed by vCenter.
+   */
+  checksum?: ContentLibraryItemVersionStatusFilesChecksum;
+  /**
+   * Name is the name of the file in the library item.
+   */
+  name: string;
+  /**
+   * Size is the size of the file in bytes.
+   */
+  size?: number;
+  /**
+   * Version is the version of the file. It is incremented when the content of the file is changed.
+   */
+  version?: string;
+}
+
+/**
+ * ContentLibraryItemVersionStatus defines the observed state of a ContentLibraryItemVersion.
+ */
+export interface ContentLibraryItemVersionStatus {
+  /**
+   * CaptureTime indicates the time when the content of the library item was observed at this version.
+   */
+  captureTime?: string;
+  /**
+   * Changes describes the files that were added, removed or changed since PreviousContentVersion, as
+   * computed by DiffFiles, so that users can see what changed when the content of the library item was
+   * updated. Unchanged files are not listed.
+   */
+  changes?: ContentLibraryItemVersionStatusChanges[];
+  /**
+   * Conditions describes the current condition information of the ContentLibraryItemVersion. Ready is True
+   * once the files and changes of the version are recorded.
+   */
+  conditions?: ContentLibraryItemVersionStatusConditions[];
+  /**
+   * Current indicates whether this is the current content version of the library item. VMs pinned to a
+   * version that is no longer current cannot be deployed from the library item until it is reverted.
+   */
+  current?: boolean;
+  /**
+   * Files describes the files of the library item at this version, including their checksums.
+   */
+  files?: ContentLibraryItemVersionStatusFiles[];
+  /**
+   * PreviousContentVersion is the content version of the library item that was recorded before this one, if
+   * any. Changes are relative to it.
+   */
+  previousContentVersion?: string;
+  /**
+   * Type is the type of the library item at this version.
+   */
+  type?: string;
+  /**
+   * UUID is the identifier of the library item in vCenter.
+   */
+  uuid?: string;
+}
+
+/**
+ * ContentLibraryItemVersion is the schema for the content library item version API.
+ * The content library operator creates a ContentLibraryItemVersion, named by ContentLibraryItemVersionName,
+ * every time the content of a ContentLibraryItem changes, so that VM specs can pin an exact image revision.
+ */
+export interface ContentLibraryItemVersion {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibraryItemVersionSpec identifies the content version of a ContentLibraryItem. It is immutable.
+   */
+  spec?: ContentLibraryItemVersionSpec;
+  /**
+   * ContentLibraryItemVersionStatus defines the observed state of a ContentLibraryItemVersion.
+   */
+  status?: ContentLibraryItemVersionStatus;
+}
+
+/**
+ * ItemRef refers to the library item whose content is materialized.
+ */
+export interface ContentLibraryItemVolumeRequestSpecItemRef {
+  /**
+   * Kind is the kind of the library item.
+   * Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+   */
+  kind: "ContentLibraryItem" | "ClusterContentLibraryItem";
+  /**
+   * Name is the name of the library item. A ContentLibraryItem must be in the namespace of the referring
+   * resource.
+   */
+  name: string;
+}
+
+/**
+ * ContentLibraryItemVolumeRequestSpec defines the desired state of a ContentLibraryItemVolumeRequest.
+ * It is immutable.
+ */
+export interface ContentLibraryItemVolumeRequestSpec {
+  /**
+   * AccessModes are the access modes of the PersistentVolumeClaim. Defaults to ReadWriteOnce.
+   */
+  accessModes?: string[];
+  /**
+   * ClaimName is the name of the PersistentVolumeClaim created in the namespace of the request. If unset,
+   * the name of the request is used.
+   */
+  claimName?: string;
+  /**
+   * FileName is the name of the file of the library item that is materialized, e.g. the disk of an OVF
+   * library item. If unset, the ISO image of an "Iso" library item, or the first disk of an "Ovf" library
+   * item, is materialized.
+   */
+  fileName?: string;
+  /**
+   * ItemRef refers to the library item whose content is materialized.
+   */
+  itemRef: ContentLibraryItemVolumeRequestSpecItemRef;
+  /**
+   * Size is the requested size of the PersistentVolumeClaim. If unset, the size of the materialized file
+   * is used. It must not be smaller than the size of the file.
+   */
+  size?: number | string;
+  /**
+   * StorageClassName is the name of the StorageClass of the PersistentVolumeClaim.
+   */
+  storageClassName: string;
+  /**
+   * TTLSecondsAfterFinished is the number of seconds after which the request is deleted once the
+   * materialization completed, so that completed requests do not accumulate. Deleting the request does not
+   * delete the PersistentVolumeClaim it created. 0 deletes the request as soon as it completed.
+   * Defaults to DefaultTTLSecondsAfterFinished.
+   */
+  ttlSecondsAfterFinished?: number;
+  /**
+   * VolumeMode is the volume mode of the PersistentVolumeClaim. Defaults to Block.
+   */
+  volumeMode?: string;
+}
+
+/**
+ * ClaimRef refers to the PersistentVolumeClaim created in the namespace of the request.
+ */
+export interface ContentLibraryItemVolumeRequestStatusClaimRef {
+  /**
+   * Name of the referent.
+   * More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+   */
+  name?: string;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryItemVolumeRequestStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * Progress describes the progress of the copy of the file to the volume.
+ * This field is populated only while the phase is "Running".
+ */
+export interface ContentLibraryItemVolumeRequestStatusProgress {
+  /**
+   * BytesTransferred is the number of bytes that have been transferred so far.
+   */
+  bytesTransferred?: number;
+  /**
+   * EstimatedCompletionTime is the estimated time at which the transfer completes.
+   */
+  estimatedCompletionTime?: string;
+  /**
+   * Percentage is the completion percentage of the transfer.
+   */
+  percentage?: number;
+  /**
+   * TotalBytes is the total number of bytes to transfer, if known.
+   */
+  totalBytes?: number;
+}
+
+/**
+ * ContentLibraryItemVolumeRequestStatus defines the observed state of a ContentLibraryItemVolumeRequest.
+ */
+export interface ContentLibraryItemVolumeRequestStatus {
+  /**
+   * ClaimRef refers to the PersistentVolumeClaim created in the namespace of the request.
+   */
+  claimRef?: ContentLibraryItemVolumeRequestStatusClaimRef;
+  /**
+   * CompletionTime indicates the time when the materialization succeeded or failed.
+   */
+  completionTime?: string;
+  /**
+   * Conditions describes the current condition information of the ContentLibraryItemVolumeRequest.
+   */
+  conditions?: ContentLibraryItemVolumeRequestStatusConditions[];
+  /**
+   * ContentVersion is the content version of the library item that was materialized.
+   */
+  contentVersion?: string;
+  /**
+   * ExpiryTime indicates the time after which the request is deleted, i.e. CompletionTime plus
+   * Spec.TTLSecondsAfterFinished. It is set once the materialization completed.
+   */
+  expiryTime?: string;
+  /**
+   * Phase indicates the phase of the materialization.
+   * Possible values are "Pending", "Running", "Succeeded" and "Failed".
+   */
+  phase?: string;
+  /**
+   * Progress describes the progress of the copy of the file to the volume.
+   * This field is populated only while the phase is "Running".
+   */
+  progress?: ContentLibraryItemVolumeRequestStatusProgress;
+}
+
+/**
+ * ContentLibraryItemVolumeRequest is the schema for the content library item volume request API.
+ * It materializes an ISO image or a disk of a library item into a new PersistentVolumeClaim, backed by a
+ * CNS volume, so that workloads can boot from the image.
+ */
+export interface ContentLibraryItemVolumeRequest {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibraryItemVolumeRequestSpec defines the desired state of a ContentLibraryItemVolumeRequest.
+   * It is immutable.
+   */
+  spec?: ContentLibraryItemVolumeRequestSpec;
+  /**
+   * ContentLibraryItemVolumeRequestStatus defines the observed state of a ContentLibraryItemVolumeRequest.
+   */
+  status?: ContentLibraryItemVolumeRequestStatus;
+}
+
+/**
+ * A label selector requirement is a selector that contains values, a key, and an operator that
+ * relates the key and values.
+ */
+export interface ContentLibraryReplicationSpecSourceItemSelectorMatchExpressions {
+  /**
+   * key is the label key that the selector applies to.
+   */
+  key: string;
+  /**
+   * operator represents a key's relationship to a set of values.
+   * Valid operators are In, NotIn, Exists and DoesNotExist.
+   */
+  operator: string;
+  /**
+   * values is an array of string values. If the operator is In or NotIn,
+   * the values array must be non-empty. If the operator is Exists or DoesNotExist,
+   * the values array must be empty. This array is replaced during a strategic
+   * merge patch.
+   */
+  values?: string[];
+}
+
+/**
+ * ItemSelector selects the library items of the source library that are replicated by their labels.
+ * If unset, all the library items are replicated.
+ */
+export interface ContentLibraryReplicationSpecSourceItemSelector {
+  /**
+   * matchExpressions is a list of label selector requirements. The requirements are ANDed.
+   */
+  matchExpressions?: ContentLibraryReplicationSpecSourceItemSelectorMatchExpressions[];
+  /**
+   * matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+   * map is equivalent to an element of matchExpressions, whose key field is "key", the
+   * operator is "In", and the values array contains only "value". The requirements are ANDed.
+   */
+  matchLabels?: { [key: string]: string };
+}
+
+/**
+ * Source refers to the library whose items are replicated.
+ */
+export interface ContentLibraryReplicationSpecSource {
+  /**
+   * ItemSelector selects the library items of the source library that are replicated by their labels.
+   * If unset, all the library items are replicated.
+   */
+  itemSelector?: ContentLibraryReplicationSpecSourceItemSelector;
+  /**
+   * Kind is the kind of the source library.
+   * Possible values are "ContentLibrary" and "ClusterContentLibrary".
+   */
+  kind: "ContentLibrary" | "ClusterContentLibrary";
+  /**
+   * Name is the name of the source library. A ContentLibrary must be in the same namespace as the
+   * ContentLibraryReplication.
+   */
+  name: string;
+}
+
+/**
+ * ContentLibraryReplicationSpec defines the desired state of a ContentLibraryReplication.
+ */
+export interface ContentLibraryReplicationSpec {
+  /**
+   * DestinationRef is the name of the writable ContentLibrary in the same namespace that the library items
+   * are replicated into. It may belong to a different vCenter than the source library.
+   */
+  destinationRef: string;
+  /**
+   * Schedule is the schedule of the replication in the cron format, e.g. "0 2 * * *". If unset, the library
+   * items are replicated whenever their content changes in the source library.
+   */
+  schedule?: string;
+  /**
+   * Source refers to the library whose items are replicated.
+   */
+  source: ContentLibraryReplicationSpecSource;
+  /**
+   * Suspend suspends the replication. Replications that are in progress are completed.
+   */
+  suspend?: boolean;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibraryReplicationStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * ItemReplicationStatus describes the observed state of the replication of a library item.
+ */
+export interface ContentLibraryReplicationStatusItems {
+  /**
+   * ContentVersion is the content version of the source library item that was last replicated.
+   */
+  contentVersion?: string;
+  /**
+   * DestinationItemRef is the name of the ContentLibraryItem created in the destination library.
+   */
+  destinationItemRef?: string;
+  /**
+   * Message describes why the replication of the library item failed.
+   */
+  message?: string;
+  /**
+   * Phase indicates the phase of the replication of the library item.
+   * Possible values are "Pending", "Running", "Succeeded" and "Failed".
+   */
+  phase?: string;
+  /**
+   * SourceItemRef is the name of the library item resource in the source library.
+   */
+  sourceItemRef: string;
+}
+
+/**
+ * ContentLibraryReplicationStatus defines the observed state of a ContentLibraryReplication.
+ */
+export interface ContentLibraryReplicationStatus {
+  /**
+   * Conditions describes the current condition information of the ContentLibraryReplication.
+   */
+  conditions?: ContentLibraryReplicationStatusConditions[];
+  /**
+   * Items describes the observed state of the replication of each selected library item.
+   */
+  items?: ContentLibraryReplicationStatusItems[];
+  /**
+   * LastCompletionTime indicates the time when the last replication completed.
+   */
+  lastCompletionTime?: string;
+  /**
+   * LastReplicationTime indicates the time when the last replication started.
+   */
+  lastReplicationTime?: string;
+}
+
+/**
+ * ContentLibraryReplication is the schema for the content library replication API.
+ * It replicates the library items of a source library into a writable destination library, possibly in
+ * another vCenter, either on a schedule or whenever their content changes.
+ */
+export interface ContentLibraryReplication {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibraryReplicationSpec defines the desired state of a ContentLibraryReplication.
+   */
+  spec?: ContentLibraryReplicationSpec;
+  /**
+   * ContentLibraryReplicationStatus defines the observed state of a ContentLibraryReplication.
+   */
+  status?: ContentLibraryReplicationStatus;
+}
+
+/**
+ * ContentLibrarySyncRequestSpec identifies the subscribed library, and optionally the library items, to
+ * synchronize. It is immutable. ClusterContentLibraries cannot be synchronized with a request, since they are
+ * shared by every namespace and the users of a namespace must not be able to synchronize them.
+ */
+export interface ContentLibrarySyncRequestSpec {
+  /**
+   * ContentLibraryRef is the name of the subscribed ContentLibrary in the same namespace to synchronize.
+   */
+  contentLibraryRef: string;
+  /**
+   * ItemRefs are the names of the ContentLibraryItem resources of the library to synchronize. If empty, the
+   * whole library is synchronized.
+   */
+  itemRefs?: string[];
+  /**
+   * TTLSecondsAfterFinished is the number of seconds after which the request is deleted once the synchronization
+   * completed, so that completed requests do not accumulate. 0 deletes the request as soon as it completed.
+   * Defaults to DefaultTTLSecondsAfterFinished.
+   */
+  ttlSecondsAfterFinished?: number;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ContentLibrarySyncRequestStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * ContentLibrarySyncRequestStatus defines the observed state of a ContentLibrarySyncRequest.
+ */
+export interface ContentLibrarySyncRequestStatus {
+  /**
+   * CompletionTime indicates the time when the synchronization succeeded or failed.
+   */
+  completionTime?: string;
+  /**
+   * Conditions describes the current condition information of the ContentLibrarySyncRequest.
+   */
+  conditions?: ContentLibrarySyncRequestStatusConditions[];
+  /**
+   * ExpiryTime indicates the time after which the request is deleted, i.e. CompletionTime plus
+   * Spec.TTLSecondsAfterFinished. It is set once the synchronization completed.
+   */
+  expiryTime?: string;
+  /**
+   * StartTime indicates the time when the synchronization was started.
+   */
+  startTime?: string;
+}
+
+/**
+ * ContentLibrarySyncRequest is the schema for the content library sync request API.
+ * It triggers the synchronization of a subscribed ContentLibrary, or of some of its items, with its publisher.
+ * Triggering a synchronization does not require the right to update the library itself, so RBAC can grant
+ * a persona the right to create ContentLibrarySyncRequests without the right to change library specs.
+ */
+export interface ContentLibrarySyncRequest {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ContentLibrarySyncRequestSpec identifies the subscribed library, and optionally the library items, to
+   * synchronize. It is immutable. ClusterContentLibraries cannot be synchronized with a request, since they are
+   * shared by every namespace and the users of a namespace must not be able to synchronize them.
+   */
+  spec?: ContentLibrarySyncRequestSpec;
+  /**
+   * ContentLibrarySyncRequestStatus defines the observed state of a ContentLibrarySyncRequest.
+   */
+  status?: ContentLibrarySyncRequestStatus;
+}
+
+/**
+ * Target refers to the library item the alias resolves to. It can be changed to move the alias to a newer
+ * library item, e.g. after an image was republished under a new UUID.
+ */
+export interface ImageAliasSpecTarget {
+  /**
+   * Kind is the kind of the library item.
+   * Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+   */
+  kind: "ContentLibraryItem" | "ClusterContentLibraryItem";
+  /**
+   * Name is the name of the library item. A ContentLibraryItem must be in the same namespace as the ImageAlias.
+   */
+  name: string;
+}
+
+/**
+ * ImageAliasSpec defines the desired state of an ImageAlias.
+ */
+export interface ImageAliasSpec {
+  /**
+   * Target refers to the library item the alias resolves to. It can be changed to move the alias to a newer
+   * library item, e.g. after an image was republished under a new UUID.
+   */
+  target: ImageAliasSpecTarget;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ImageAliasStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * ImageAliasStatus defines the observed state of an ImageAlias.
+ */
+export interface ImageAliasStatus {
+  /**
+   * Conditions describes the current condition information of the ImageAlias.
+   */
+  conditions?: ImageAliasStatusConditions[];
+  /**
+   * ContentVersion is the content version of the library item the alias currently resolves to.
+   */
+  contentVersion?: string;
+  /**
+   * UUID is the vCenter identifier of the library item the alias currently resolves to.
+   */
+  uuid?: string;
+}
+
+/**
+ * ImageAlias is the schema for the image alias API.
+ * An ImageAlias gives a library item a short, stable name, e.g. "ubuntu-22.04", that VM specs can refer to
+ * instead of the generated name of the library item resource.
+ */
+export interface ImageAlias {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ImageAliasSpec defines the desired state of an ImageAlias.
+   */
+  spec?: ImageAliasSpec;
+  /**
+   * ImageAliasStatus defines the observed state of an ImageAlias.
+   */
+  status?: ImageAliasStatus;
+}
+
+/**
+ * A label selector requirement is a selector that contains values, a key, and an operator that
+ * relates the key and values.
+ */
+export interface ImageFamilySpecSelectorMatchExpressions {
+  /**
+   * key is the label key that the selector applies to.
+   */
+  key: string;
+  /**
+   * operator represents a key's relationship to a set of values.
+   * Valid operators are In, NotIn, Exists and DoesNotExist.
+   */
+  operator: string;
+  /**
+   * values is an array of string values. If the operator is In or NotIn,
+   * the values array must be non-empty. If the operator is Exists or DoesNotExist,
+   * the values array must be empty. This array is replaced during a strategic
+   * merge patch.
+   */
+  values?: string[];
+}
+
+/**
+ * Selector selects the library items that are members of the family by their labels.
+ */
+export interface ImageFamilySpecSelector {
+  /**
+   * matchExpressions is a list of label selector requirements. The requirements are ANDed.
+   */
+  matchExpressions?: ImageFamilySpecSelectorMatchExpressions[];
+  /**
+   * matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+   * map is equivalent to an element of matchExpressions, whose key field is "key", the
+   * operator is "In", and the values array contains only "value". The requirements are ANDed.
+   */
+  matchLabels?: { [key: string]: string };
+}
+
+/**
+ * ImageFamilySpec defines the desired state of an ImageFamily.
+ * At least one of NamePattern and Selector must be set. If both are set, the members of the family must
+ * match both.
+ */
+export interface ImageFamilySpec {
+  /**
+   * ItemKind is the kind of the library items that are members of the family. ContentLibraryItems must be
+   * in the same namespace as the ImageFamily.
+   * Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+   */
+  itemKind: "ContentLibraryItem" | "ClusterContentLibraryItem";
+  /**
+   * NamePattern is a shell pattern, e.g. "photon-4-*", matched against the name of the library items in
+   * vCenter. The pattern syntax is the one of the Go path.Match function.
+   */
+  namePattern?: string;
+  /**
+   * Selector selects the library items that are members of the family by their labels.
+   */
+  selector?: ImageFamilySpecSelector;
+}
+
+/**
+ * Condition defines an observation of a VM Operator API resource operational state.
+ */
+export interface ImageFamilyStatusConditions {
+  /**
+   * Last time the condition transitioned from one status to another.
+   * This should be when the underlying condition changed. If that is not known, then using the time when
+   * the API field changed is acceptable.
+   */
+  lastTransitionTime: string;
+  /**
+   * A human readable message indicating details about the transition.
+   * This field may be empty.
+   */
+  message?: string;
+  /**
+   * ObservedGeneration is the .metadata.generation of the resource the condition was set for, so that
+   * clients can tell whether the condition reflects the current spec of the resource. It is only set by
+   * controllers that track it, e.g. for the Ready condition.
+   */
+  observedGeneration?: number;
+  /**
+   * The reason for the condition's last transition in CamelCase.
+   * The specific API may choose whether or not this field is considered a guaranteed API.
+   * This field may not be empty.
+   */
+  reason?: string;
+  /**
+   * Severity provides an explicit classification of Reason code, so the users or machines can immediately
+   * understand the current situation and act accordingly.
+   * The Severity field MUST be set only when Status=False.
+   */
+  severity?: string;
+  /**
+   * Status of the condition, one of True, False, Unknown.
+   */
+  status: string;
+  /**
+   * Type of condition in CamelCase or in foo.example.com/CamelCase.
+   * Many .condition.type values are consistent across resources like Available, but because arbitrary conditions
+   * can be useful (see .node.status.conditions), the ability to deconflict is important.
+   */
+  type: string;
+}
+
+/**
+ * Latest refers to the latest ready member of the family, i.e. the ready member that was created last in
+ * vCenter. VM specs that request the latest image of the family are resolved to it.
+ */
+export interface ImageFamilyStatusLatest {
+  /**
+   * Kind is the kind of the library item.
+   * Possible values are "ContentLibraryItem" and "ClusterContentLibraryItem".
+   */
+  kind: "ContentLibraryItem" | "ClusterContentLibraryItem";
+  /**
+   * Name is the name of the library item. A ContentLibraryItem must be in the namespace of the referring
+   * resource.
+   */
+  name: string;
+}
+
+/**
+ * ImageFamilyStatus defines the observed state of an ImageFamily.
+ */
+export interface ImageFamilyStatus {
+  /**
+   * Conditions describes the current condition information of the ImageFamily.
+   */
+  conditions?: ImageFamilyStatusConditions[];
+  /**
+   * Latest refers to the latest ready member of the family, i.e. the ready member that was created last in
+   * vCenter. VM specs that request the latest image of the family are resolved to it.
+   */
+  latest?: ImageFamilyStatusLatest;
+  /**
+   * LatestContentVersion is the content version of the latest ready member of the family.
+   */
+  latestContentVersion?: string;
+  /**
+   * LatestUUID is the vCenter identifier of the latest ready member of the family.
+   */
+  latestUUID?: string;
+  /**
+   * Members is the number of library items that are members of the family, whether they are ready or not.
+   */
+  members?: number;
+}
+
+/**
+ * ImageFamily is the schema for the image family API.
+ * An ImageFamily groups the library items of a line of images, e.g. all the "photon-4-*" items, and
+ * resolves to the latest ready one, so that VM specs can request the latest image of a family without
+ * resolving it themselves.
+ */
+export interface ImageFamily {
+  /**
+   * APIVersion defines the versioned schema of this representation of an object.
+   * Servers should convert recognized schemas to the latest internal value, and
+   * may reject unrecognized values.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+   */
+  apiVersion?: string;
+  /**
+   * Kind is a string value representing the REST resource this object represents.
+   * Servers may infer this from the endpoint the client submits requests to.
+   * Cannot be updated.
+   * In CamelCase.
+   * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+   */
+  kind?: string;
+  metadata?: ObjectMeta;
+  /**
+   * ImageFamilySpec defines the desired state of an ImageFamily.
+   * At least one of NamePattern and Selector must be set. If both are set, the members of the family must
+   * match both.
+   */
+  spec?: ImageFamilySpec;
+  /**
+   * ImageFamilyStatus defines the observed state of an ImageFamily.
+   */
+  status?: ImageFamilyStatus;
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// openapi-export writes the OpenAPI v3 schemas of the kinds of the image registry API. By default it writes
+// a single OpenAPI document to stdout. With -output-dir, it writes the schema of every kind and version to
+// its own "<kind>_<version>.json" file instead.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/openapi"
+)
+
+func main() {
+	outputDir := flag.String("output-dir", "", "directory to write one schema file per kind and version to, instead of stdout")
+	flag.Parse()
+
+	var err error
+	if *outputDir == "" {
+		err = writeDocument()
+	} else {
+		err = writeSchemas(*outputDir)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to export the OpenAPI schemas: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func writeDocument() error {
+	document, err := openapi.Document()
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
+
+func writeSchemas(dir string) error {
+	schemas, err := openapi.Schemas()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, s := range schemas {
+		data, err := json.MarshalIndent(s.OpenAPIV3Schema, "", "  ")
+		if err != nil {
+			return err
+		}
+		gvk := s.GroupVersionKind
+		name := filepath.Join(dir, fmt.Sprintf("%s_%s.json", strings.ToLower(gvk.Kind), gvk.Version))
+		if err := os.WriteFile(name, append(data, '\n'), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
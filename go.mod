@@ -5,9 +5,11 @@ go 1.17
 require (
 	k8s.io/api v0.22.0
 	k8s.io/apimachinery v0.22.0
+	sigs.k8s.io/yaml v1.2.0
 )
 
 require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/go-logr/logr v0.4.0 // indirect
 	github.com/gogo/protobuf v1.3.2 // indirect
 	github.com/google/go-cmp v0.5.5 // indirect
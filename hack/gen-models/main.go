@@ -0,0 +1,157 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// gen-models writes TypeScript interfaces for every kind of the image registry API to stdout, so that user
+// interfaces and automation consuming the resources do not hand-write models that drift from the API types.
+// The interfaces are derived from the OpenAPI v3 schemas exported by pkg/openapi. Nested objects are named
+// after the path that leads to them, e.g. ContentLibrarySpecPublish.
+//
+// The CRD schemas inline every nested type instead of referring to a shared definition, so a Go type used in
+// several places, e.g. Condition or FileInfo, is emitted once per place it is used, and the interfaces are not
+// named after the Go types. The openapi-gen definitions would keep the Go type names, but would need another
+// code generator and would not carry the kubebuilder markers and CEL rules the API server enforces. The CRD
+// schemas are used because the models then describe exactly what the API server accepts; the cost is a larger
+// models.ts whose duplicated interfaces are structurally identical, and therefore interchangeable, in
+// TypeScript.
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/openapi"
+)
+
+const header = `// Code generated by gen-models. DO NOT EDIT.
+
+/** ObjectMeta is the standard Kubernetes object metadata. */
+export interface ObjectMeta {
+  name?: string;
+  namespace?: string;
+  labels?: { [key: string]: string };
+  annotations?: { [key: string]: string };
+  [key: string]: unknown;
+}
+`
+
+// generator accumulates the interfaces derived from the schemas, in the order they are first referred to.
+type generator struct {
+	b strings.Builder
+}
+
+func main() {
+	schemas, err := openapi.Schemas()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read the OpenAPI schemas: %v\n", err)
+		os.Exit(1)
+	}
+
+	g := &generator{}
+	g.b.WriteString(header)
+	for _, s := range schemas {
+		g.object(s.GroupVersionKind.Kind, s.OpenAPIV3Schema, true)
+	}
+	fmt.Print(g.b.String())
+}
+
+// object writes the interface of an object schema, after the interfaces of its nested objects.
+func (g *generator) object(name string, schema map[string]interface{}, root bool) {
+	properties, _ := schema["properties"].(map[string]interface{})
+	required := map[string]bool{}
+	for _, r := range asSlice(schema["required"]) {
+		required[fmt.Sprint(r)] = true
+	}
+
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var body strings.Builder
+	for _, key := range keys {
+		property, _ := properties[key].(map[string]interface{})
+		var t string
+		if root && key == "metadata" {
+			t = "ObjectMeta"
+		} else {
+			t = g.typeOf(name+exportedName(key), property)
+		}
+		writeComment(&body, "  ", property["description"])
+		optional := "?"
+		if required[key] {
+			optional = ""
+		}
+		fmt.Fprintf(&body, "  %s%s: %s;\n", key, optional, t)
+	}
+
+	g.b.WriteString("\n")
+	writeComment(&g.b, "", schema["description"])
+	fmt.Fprintf(&g.b, "export interface %s {\n%s}\n", name, body.String())
+}
+
+// typeOf returns the TypeScript type of a schema, writing the interfaces of the objects it contains.
+func (g *generator) typeOf(name string, schema map[string]interface{}) string {
+	if schema["x-kubernetes-int-or-string"] == true {
+		return "number | string"
+	}
+	if enum := asSlice(schema["enum"]); len(enum) > 0 {
+		values := make([]string, len(enum))
+		for i, value := range enum {
+			values[i] = fmt.Sprintf("%q", value)
+		}
+		return strings.Join(values, " | ")
+	}
+
+	switch schema["type"] {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		items, _ := schema["items"].(map[string]interface{})
+		t := g.typeOf(name, items)
+		if strings.Contains(t, " ") {
+			t = "(" + t + ")"
+		}
+		return t + "[]"
+	case "object":
+		if _, ok := schema["properties"]; ok {
+			g.object(name, schema, false)
+			return name
+		}
+		if additional, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+			return fmt.Sprintf("{ [key: string]: %s }", g.typeOf(name+"Value", additional))
+		}
+	}
+	return "unknown"
+}
+
+func writeComment(b *strings.Builder, indent string, description interface{}) {
+	text, ok := description.(string)
+	if !ok || text == "" {
+		return
+	}
+	text = strings.ReplaceAll(text, "*/", "*\\/")
+	fmt.Fprintf(b, "%s/**\n", indent)
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		fmt.Fprintf(b, "%s * %s\n", indent, strings.TrimRight(line, " "))
+	}
+	fmt.Fprintf(b, "%s */\n", indent)
+}
+
+// exportedName returns the name of a property with its first letter in upper case, e.g. "Spec" for "spec".
+func exportedName(property string) string {
+	if property == "" {
+		return property
+	}
+	return strings.ToUpper(property[:1]) + property[1:]
+}
+
+func asSlice(value interface{}) []interface{} {
+	slice, _ := value.([]interface{})
+	return slice
+}
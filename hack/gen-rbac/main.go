@@ -0,0 +1,23 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// gen-rbac writes the ClusterRoles of the image registry API as a YAML stream to stdout.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/rbac"
+	"sigs.k8s.io/yaml"
+)
+
+func main() {
+	for _, role := range rbac.ClusterRoles() {
+		data, err := yaml.Marshal(role)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to marshal ClusterRole %s: %v\n", role.Name, err)
+			os.Exit(1)
+		}
+		fmt.Printf("---\n%s", data)
+	}
+}
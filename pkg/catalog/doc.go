@@ -0,0 +1,7 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package catalog sorts and paginates lists of library items, so that the command line and user interfaces
+// rendering catalogs of thousands of items order and page them identically. The order is total: items with
+// the same sort value are ordered by their name in vCenter, then by namespace and name, so that pages are
+// stable across requests.
+package catalog
@@ -0,0 +1,77 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package catalog
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/matcher"
+)
+
+// ErrInvalidContinueToken is returned, possibly wrapped, when a continue token cannot be decoded or was issued
+// for another order.
+var ErrInvalidContinueToken = errors.New("invalid continue token")
+
+// continueToken is the position of the last item of a page, encoded as an opaque string. Since it holds the
+// sort key of the item rather than its index, the next page does not skip or repeat items when items before
+// the position are added or removed between the requests.
+type continueToken struct {
+	Order Order   `json:"order"`
+	After sortKey `json:"after"`
+}
+
+// Paginate returns the page of at most limit items that follows the position encoded in continueToken, in the
+// given order, and the continue token of the next page. The first page is requested with an empty continue
+// token, and the returned continue token is empty for the last page. A limit of 0 or less returns every
+// remaining item. Paginate sorts a copy of items, which are therefore not required to be sorted.
+func Paginate(items []matcher.Item, order Order, limit int, token string) ([]matcher.Item, string, error) {
+	sorted := append([]matcher.Item(nil), items...)
+	if err := Sort(sorted, order); err != nil {
+		return nil, "", err
+	}
+
+	start := 0
+	if token != "" {
+		after, err := decodeContinueToken(token, order)
+		if err != nil {
+			return nil, "", err
+		}
+		start = sort.Search(len(sorted), func(i int) bool {
+			return after.less(keyOf(sorted[i], order.Field), order)
+		})
+	}
+
+	if limit <= 0 || limit >= len(sorted)-start {
+		return sorted[start:], "", nil
+	}
+
+	page := sorted[start : start+limit]
+	next, err := encodeContinueToken(continueToken{Order: order, After: keyOf(page[len(page)-1], order.Field)})
+	if err != nil {
+		return nil, "", err
+	}
+	return page, next, nil
+}
+
+func encodeContinueToken(token continueToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeContinueToken(encoded string, order Order) (sortKey, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return sortKey{}, ErrInvalidContinueToken
+	}
+	var token continueToken
+	if err := json.Unmarshal(data, &token); err != nil || token.Order != order {
+		return sortKey{}, ErrInvalidContinueToken
+	}
+	return token.After, nil
+}
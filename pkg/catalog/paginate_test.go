@@ -0,0 +1,213 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package catalog_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/catalog"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/matcher"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func item(resource, name, creationTime string, size int32) matcher.Item {
+	return &v1alpha1.ContentLibraryItem{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: resource},
+		Status:     v1alpha1.ContentLibraryItemStatus{Name: name, CreationTime: creationTime, Size: size},
+	}
+}
+
+// catalogItems returns items whose names, creation times and sizes are ordered differently, with ties that
+// are broken by the name in vCenter and then by the name of the resource.
+func catalogItems() []matcher.Item {
+	return []matcher.Item{
+		item("clitem-c", "centos", "2022-01-03T00:00:00Z", 300),
+		item("clitem-a", "alpine", "2022-01-02T00:00:00Z", 500),
+		item("clitem-u2", "ubuntu", "2022-01-01T00:00:00Z", 100),
+		item("clitem-u1", "ubuntu", "2022-01-01T00:00:00Z", 100),
+		item("clitem-d", "debian", "2022-01-04T00:00:00Z", 200),
+	}
+}
+
+func resourceNames(items []matcher.Item) []string {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		names = append(names, item.GetName())
+	}
+	return names
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		order    catalog.Order
+		expected []string
+	}{
+		{
+			order:    catalog.Order{Field: catalog.SortByName},
+			expected: []string{"clitem-a", "clitem-c", "clitem-d", "clitem-u1", "clitem-u2"},
+		},
+		{
+			order:    catalog.Order{Field: catalog.SortByName, Descending: true},
+			expected: []string{"clitem-u2", "clitem-u1", "clitem-d", "clitem-c", "clitem-a"},
+		},
+		{
+			order:    catalog.Order{Field: catalog.SortByCreationTime},
+			expected: []string{"clitem-u1", "clitem-u2", "clitem-a", "clitem-c", "clitem-d"},
+		},
+		{
+			order:    catalog.Order{Field: catalog.SortByCreationTime, Descending: true},
+			expected: []string{"clitem-d", "clitem-c", "clitem-a", "clitem-u2", "clitem-u1"},
+		},
+		{
+			order:    catalog.Order{Field: catalog.SortBySize},
+			expected: []string{"clitem-u1", "clitem-u2", "clitem-d", "clitem-c", "clitem-a"},
+		},
+		{
+			order:    catalog.Order{Field: catalog.SortBySize, Descending: true},
+			expected: []string{"clitem-a", "clitem-c", "clitem-d", "clitem-u2", "clitem-u1"},
+		},
+	}
+
+	for _, tt := range tests {
+		name := string(tt.order.Field)
+		if tt.order.Descending {
+			name += "/descending"
+		}
+		t.Run(name, func(t *testing.T) {
+			items := catalogItems()
+			if err := catalog.Sort(items, tt.order); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if names := resourceNames(items); !reflect.DeepEqual(names, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, names)
+			}
+		})
+	}
+}
+
+func TestSortUnsupportedField(t *testing.T) {
+	if err := catalog.Sort(catalogItems(), catalog.Order{Field: "version"}); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	for _, order := range []catalog.Order{
+		{Field: catalog.SortByName},
+		{Field: catalog.SortByCreationTime, Descending: true},
+		{Field: catalog.SortBySize},
+	} {
+		for _, limit := range []int{0, 1, 2, 5, 6} {
+			items := catalogItems()
+			expected := catalogItems()
+			if err := catalog.Sort(expected, order); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var names []string
+			token := ""
+			for pages := 0; ; pages++ {
+				if pages > len(items) {
+					t.Fatalf("%s, limit %d: the pagination did not end", order.Field, limit)
+				}
+				page, next, err := catalog.Paginate(items, order, limit, token)
+				if err != nil {
+					t.Fatalf("%s, limit %d: unexpected error: %v", order.Field, limit, err)
+				}
+				if limit > 0 && len(page) > limit {
+					t.Errorf("%s, limit %d: got a page of %d items", order.Field, limit, len(page))
+				}
+				names = append(names, resourceNames(page)...)
+				if next == "" {
+					break
+				}
+				token = next
+			}
+			if !reflect.DeepEqual(names, resourceNames(expected)) {
+				t.Errorf("%s, limit %d: expected %v, got %v", order.Field, limit, resourceNames(expected), names)
+			}
+		}
+	}
+}
+
+func TestPaginateAfterTheLastItem(t *testing.T) {
+	order := catalog.Order{Field: catalog.SortByName}
+	items := catalogItems()
+	_, token, err := catalog.Paginate(items, order, 4, "")
+	if err != nil || token == "" {
+		t.Fatalf("expected a continue token, got %q, %v", token, err)
+	}
+
+	// The last item is deleted before the next page is requested, so the token points at the last item.
+	var remaining []matcher.Item
+	for _, item := range items {
+		if item.GetName() != "clitem-u2" {
+			remaining = append(remaining, item)
+		}
+	}
+	page, next, err := catalog.Paginate(remaining, order, 4, token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page) != 0 || next != "" {
+		t.Errorf("expected an empty last page, got %v and %q", resourceNames(page), next)
+	}
+}
+
+func TestPaginateWithChangingItems(t *testing.T) {
+	order := catalog.Order{Field: catalog.SortByName}
+	items := catalogItems()
+	first, token, err := catalog.Paginate(items, order, 2, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if names := resourceNames(first); !reflect.DeepEqual(names, []string{"clitem-a", "clitem-c"}) {
+		t.Fatalf("unexpected first page %v", names)
+	}
+
+	// An item is inserted before the position of the token and another one after it, and an item of the
+	// first page is deleted.
+	changed := append([]matcher.Item{}, items[2:]...)
+	changed = append(changed,
+		item("clitem-b", "busybox", "2022-01-05T00:00:00Z", 10),
+		item("clitem-f", "fedora", "2022-01-05T00:00:00Z", 10))
+
+	rest, next, err := catalog.Paginate(changed, order, 0, token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"clitem-d", "clitem-f", "clitem-u1", "clitem-u2"}
+	if names := resourceNames(rest); !reflect.DeepEqual(names, expected) || next != "" {
+		t.Errorf("expected %v, got %v and %q", expected, names, next)
+	}
+}
+
+func TestPaginateInvalidContinueToken(t *testing.T) {
+	items := catalogItems()
+	_, token, err := catalog.Paginate(items, catalog.Order{Field: catalog.SortByName}, 2, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		order catalog.Order
+		token string
+	}{
+		{name: "another field", order: catalog.Order{Field: catalog.SortBySize}, token: token},
+		{name: "another direction", order: catalog.Order{Field: catalog.SortByName, Descending: true}, token: token},
+		{name: "not base64", order: catalog.Order{Field: catalog.SortByName}, token: "not a token!"},
+		{name: "not JSON", order: catalog.Order{Field: catalog.SortByName}, token: "bm90IGpzb24"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := catalog.Paginate(items, tt.order, 2, tt.token); !errors.Is(err, catalog.ErrInvalidContinueToken) {
+				t.Errorf("expected ErrInvalidContinueToken, got %v", err)
+			}
+		})
+	}
+}
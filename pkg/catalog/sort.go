@@ -0,0 +1,143 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package catalog
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/matcher"
+)
+
+// SortField is the field items are sorted by.
+type SortField string
+
+const (
+	// SortByName sorts items by their name in vCenter.
+	SortByName = SortField("name")
+
+	// SortByCreationTime sorts items by the time they were created in vCenter.
+	SortByCreationTime = SortField("creationTime")
+
+	// SortBySize sorts items by their size.
+	SortBySize = SortField("size")
+)
+
+// Order describes how items are sorted.
+type Order struct {
+	// Field is the field items are sorted by.
+	Field SortField `json:"field"`
+
+	// Descending sorts items in descending order.
+	Descending bool `json:"descending,omitempty"`
+}
+
+// Sort sorts items in place in the given order. Items that are neither a ContentLibraryItem nor a
+// ClusterContentLibraryItem sort as if their status were empty.
+func Sort(items []matcher.Item, order Order) error {
+	if err := order.validate(); err != nil {
+		return err
+	}
+
+	keys := make([]sortKey, len(items))
+	for i, item := range items {
+		keys[i] = keyOf(item, order.Field)
+	}
+	sort.Sort(byKey{items: items, keys: keys, order: order})
+	return nil
+}
+
+func (order Order) validate() error {
+	switch order.Field {
+	case SortByName, SortByCreationTime, SortBySize:
+		return nil
+	}
+	return fmt.Errorf("unsupported sort field %q", order.Field)
+}
+
+// sortKey holds the values items are compared by: the value of the sort field, then the name of the item in
+// vCenter, then the namespace and name of its resource.
+type sortKey struct {
+	Value     int64  `json:"value,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Resource  string `json:"resource"`
+}
+
+func keyOf(item matcher.Item, field SortField) sortKey {
+	key := sortKey{Namespace: item.GetNamespace(), Resource: item.GetName()}
+
+	var creationTime string
+	var size int32
+	switch obj := item.(type) {
+	case *v1alpha1.ContentLibraryItem:
+		key.Name, creationTime, size = obj.Status.Name, obj.Status.CreationTime, obj.Status.Size
+	case *v1alpha1.ClusterContentLibraryItem:
+		key.Name, creationTime, size = obj.Status.Name, obj.Status.CreationTime, obj.Status.Size
+	}
+
+	switch field {
+	case SortByCreationTime:
+		created, err := time.Parse(time.RFC3339, creationTime)
+		if err != nil {
+			created = item.GetCreationTimestamp().Time
+		}
+		key.Value = created.UnixNano()
+	case SortBySize:
+		key.Value = int64(size)
+	}
+	return key
+}
+
+// compare returns a negative number if a sorts before b in ascending order, 0 if they are equal, and a
+// positive number otherwise.
+func (a sortKey) compare(b sortKey) int {
+	switch {
+	case a.Value != b.Value:
+		if a.Value < b.Value {
+			return -1
+		}
+		return 1
+	case a.Name != b.Name:
+		if a.Name < b.Name {
+			return -1
+		}
+		return 1
+	case a.Namespace != b.Namespace:
+		if a.Namespace < b.Namespace {
+			return -1
+		}
+		return 1
+	case a.Resource != b.Resource:
+		if a.Resource < b.Resource {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// less returns true if a sorts before b in the given order.
+func (a sortKey) less(b sortKey, order Order) bool {
+	if order.Descending {
+		return a.compare(b) > 0
+	}
+	return a.compare(b) < 0
+}
+
+type byKey struct {
+	items []matcher.Item
+	keys  []sortKey
+	order Order
+}
+
+func (s byKey) Len() int { return len(s.items) }
+
+func (s byKey) Less(i, j int) bool { return s.keys[i].less(s.keys[j], s.order) }
+
+func (s byKey) Swap(i, j int) {
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package conditions
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApprovalSetter is a resource whose content can be approved, i.e. a ContentLibraryItem or a
+// ClusterContentLibraryItem.
+type ApprovalSetter interface {
+	metav1.Object
+	Setter
+}
+
+// SetApproval sets the ApprovalRequired and Approved conditions of the library item, given whether its
+// content requires an approval and the digest of its current content. Approved is True if the item has at
+// least one approval of that digest, and is removed if no approval is required. Controllers pass
+// v1alpha1.ConditionApproved to SetReady so that items pending approval are not Ready.
+func SetApproval(to ApprovalSetter, required bool, digest string) {
+	if !required {
+		Set(to, FalseCondition(v1alpha1.ConditionApprovalRequired, v1alpha1.ApprovalNotRequiredReason,
+			v1alpha1.ConditionSeverityInfo, "the content of the library item does not require an approval"))
+		Delete(to, v1alpha1.ConditionApproved)
+		return
+	}
+	MarkTrue(to, v1alpha1.ConditionApprovalRequired)
+
+	if len(v1alpha1.ApprovalsOf(to, digest)) > 0 {
+		MarkTrue(to, v1alpha1.ConditionApproved)
+		return
+	}
+
+	approvals, _ := v1alpha1.Approvals(to)
+	if len(approvals) > 0 {
+		MarkFalse(to, v1alpha1.ConditionApproved, v1alpha1.ApprovalStaleReason, v1alpha1.ConditionSeverityWarning,
+			"the content of the library item changed since it was approved")
+		return
+	}
+	MarkFalse(to, v1alpha1.ConditionApproved, v1alpha1.ApprovalPendingReason, v1alpha1.ConditionSeverityInfo,
+		"the content of the library item is waiting for approval")
+}
@@ -0,0 +1,4 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package conditions implements helpers to read and update the conditions of the image registry resources.
+package conditions
@@ -0,0 +1,48 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package conditions
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Getter interface defines methods that an image registry object should implement in order to
+// use the conditions package for getting conditions.
+type Getter interface {
+	GetConditions() v1alpha1.Conditions
+}
+
+// Get returns the condition with the given type, if the condition does not exist, it returns nil.
+func Get(from Getter, t v1alpha1.ConditionType) *v1alpha1.Condition {
+	conditions := from.GetConditions()
+	for i := range conditions {
+		if conditions[i].Type == t {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// Has returns true if a condition with the given type exists.
+func Has(from Getter, t v1alpha1.ConditionType) bool {
+	return Get(from, t) != nil
+}
+
+// IsTrue is true if the condition with the given type is True, otherwise it returns false
+// if the condition is not True or if the condition does not exist (is nil).
+func IsTrue(from Getter, t v1alpha1.ConditionType) bool {
+	if c := Get(from, t); c != nil {
+		return c.Status == corev1.ConditionTrue
+	}
+	return false
+}
+
+// IsFalse is true if the condition with the given type is False, otherwise it returns false
+// if the condition is not False or if the condition does not exist (is nil).
+func IsFalse(from Getter, t v1alpha1.ConditionType) bool {
+	if c := Get(from, t); c != nil {
+		return c.Status == corev1.ConditionFalse
+	}
+	return false
+}
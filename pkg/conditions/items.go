@@ -0,0 +1,53 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package conditions
+
+import (
+	"strings"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+)
+
+// maxNotReadyItemNames is the maximum number of not ready item names included in the ItemsReady message.
+const maxNotReadyItemNames = 3
+
+// ItemsReadyCondition returns the ItemsReady condition of a ContentLibrary from the given library items.
+func ItemsReadyCondition(items []v1alpha1.ContentLibraryItem) *v1alpha1.Condition {
+	var notReady []string
+	for i := range items {
+		if !items[i].Status.Ready {
+			notReady = append(notReady, items[i].Name)
+		}
+	}
+	return itemsReadyCondition(len(items), notReady)
+}
+
+// ClusterItemsReadyCondition returns the ItemsReady condition of a ClusterContentLibrary from the given
+// library items.
+func ClusterItemsReadyCondition(items []v1alpha1.ClusterContentLibraryItem) *v1alpha1.Condition {
+	var notReady []string
+	for i := range items {
+		if !items[i].Status.Ready {
+			notReady = append(notReady, items[i].Name)
+		}
+	}
+	return itemsReadyCondition(len(items), notReady)
+}
+
+func itemsReadyCondition(total int, notReady []string) *v1alpha1.Condition {
+	if len(notReady) == 0 {
+		return TrueCondition(v1alpha1.ContentLibraryConditionItemsReady)
+	}
+
+	names := notReady
+	if len(names) > maxNotReadyItemNames {
+		names = names[:maxNotReadyItemNames]
+	}
+	message := strings.Join(names, ", ")
+	if len(notReady) > len(names) {
+		message += ", ..."
+	}
+
+	return FalseCondition(v1alpha1.ContentLibraryConditionItemsReady, v1alpha1.ItemsNotReadyReason,
+		v1alpha1.ConditionSeverityWarning, "%d of %d items are not ready: %s", len(notReady), total, message)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package conditions
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SetReady sets the Ready condition of the resource from the given conditions, which must be True when
+// their aspect of the resource is healthy, e.g. ItemsReady or Uploaded. Ready is True if all of them are
+// True, and otherwise has the status, reason, severity and message of the first one that is not. Conditions
+// the resource does not have are ignored, since some conditions are only reported in some configurations.
+// The ObservedGeneration of Ready is set to the generation of the resource, so that clients waiting for the
+// resource to be Ready can tell whether the condition reflects its current spec.
+func SetReady(to Setter, dependencies ...v1alpha1.ConditionType) {
+	ready := TrueCondition(v1alpha1.ConditionReady)
+	for _, t := range dependencies {
+		c := Get(to, t)
+		if c == nil || c.Status == corev1.ConditionTrue {
+			continue
+		}
+		ready = &v1alpha1.Condition{
+			Type:     v1alpha1.ConditionReady,
+			Status:   c.Status,
+			Reason:   c.Reason,
+			Severity: c.Severity,
+			Message:  c.Message,
+		}
+		break
+	}
+	if obj, ok := to.(metav1.Object); ok {
+		ready.ObservedGeneration = obj.GetGeneration()
+	}
+	Set(to, ready)
+}
+
+// IsReady returns true if the resource has the Ready condition with Status=True.
+func IsReady(from Getter) bool {
+	return IsTrue(from, v1alpha1.ConditionReady)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package conditions_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1/install"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/conditions"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSetReady(t *testing.T) {
+	uploaded := conditions.FalseCondition(v1alpha1.ConditionType("Uploaded"), "UploadFailed",
+		v1alpha1.ConditionSeverityError, "the upload of %s failed", "disk.vmdk")
+	transferring := conditions.FalseCondition(v1alpha1.ContentLibraryItemConditionTransferring, "Waiting",
+		v1alpha1.ConditionSeverityInfo, "the transfer is queued")
+	unknown := &v1alpha1.Condition{Type: v1alpha1.ContentLibraryItemConditionSignatureVerified,
+		Status: corev1.ConditionUnknown, Reason: "Verifying", Message: "the signature is being verified"}
+
+	tests := []struct {
+		name         string
+		conditions   []*v1alpha1.Condition
+		dependencies []v1alpha1.ConditionType
+		expected     v1alpha1.Condition
+	}{
+		{
+			name:     "no dependencies",
+			expected: v1alpha1.Condition{Status: corev1.ConditionTrue},
+		},
+		{
+			name:         "missing dependencies are ignored",
+			dependencies: []v1alpha1.ConditionType{uploaded.Type},
+			expected:     v1alpha1.Condition{Status: corev1.ConditionTrue},
+		},
+		{
+			name:         "all dependencies are true",
+			conditions:   []*v1alpha1.Condition{conditions.TrueCondition(uploaded.Type)},
+			dependencies: []v1alpha1.ConditionType{uploaded.Type},
+			expected:     v1alpha1.Condition{Status: corev1.ConditionTrue},
+		},
+		{
+			name:         "a false dependency",
+			conditions:   []*v1alpha1.Condition{uploaded},
+			dependencies: []v1alpha1.ConditionType{uploaded.Type},
+			expected: v1alpha1.Condition{Status: corev1.ConditionFalse, Reason: "UploadFailed",
+				Severity: v1alpha1.ConditionSeverityError, Message: "the upload of disk.vmdk failed"},
+		},
+		{
+			name:         "the first dependency that is not true wins",
+			conditions:   []*v1alpha1.Condition{uploaded, transferring},
+			dependencies: []v1alpha1.ConditionType{transferring.Type, uploaded.Type},
+			expected: v1alpha1.Condition{Status: corev1.ConditionFalse, Reason: "Waiting",
+				Severity: v1alpha1.ConditionSeverityInfo, Message: "the transfer is queued"},
+		},
+		{
+			name:         "an unknown dependency",
+			conditions:   []*v1alpha1.Condition{conditions.TrueCondition(uploaded.Type), unknown},
+			dependencies: []v1alpha1.ConditionType{uploaded.Type, unknown.Type},
+			expected: v1alpha1.Condition{Status: corev1.ConditionUnknown, Reason: "Verifying",
+				Message: "the signature is being verified"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &v1alpha1.ContentLibraryItem{}
+			item.Generation = 3
+			for _, c := range tt.conditions {
+				conditions.Set(item, c.DeepCopy())
+			}
+
+			conditions.SetReady(item, tt.dependencies...)
+
+			ready := conditions.Get(item, v1alpha1.ConditionReady)
+			if ready == nil {
+				t.Fatal("expected the Ready condition to be set")
+			}
+			if ready.Status != tt.expected.Status || ready.Reason != tt.expected.Reason ||
+				ready.Severity != tt.expected.Severity || ready.Message != tt.expected.Message {
+				t.Errorf("expected %+v, got %+v", tt.expected, *ready)
+			}
+			if ready.ObservedGeneration != 3 {
+				t.Errorf("expected the observed generation 3, got %d", ready.ObservedGeneration)
+			}
+			if isReady := conditions.IsReady(item); isReady != (tt.expected.Status == corev1.ConditionTrue) {
+				t.Errorf("expected IsReady to be %t", !isReady)
+			}
+		})
+	}
+}
+
+func TestSetReadyObservedGeneration(t *testing.T) {
+	item := &v1alpha1.ContentLibraryItem{}
+	item.Generation = 1
+	conditions.SetReady(item)
+	transitioned := conditions.Get(item, v1alpha1.ConditionReady).LastTransitionTime
+
+	item.Generation = 2
+	conditions.SetReady(item)
+	ready := conditions.Get(item, v1alpha1.ConditionReady)
+	if ready.ObservedGeneration != 2 {
+		t.Errorf("expected the observed generation 2, got %d", ready.ObservedGeneration)
+	}
+	if !ready.LastTransitionTime.Equal(&transitioned) {
+		t.Errorf("expected the transition time to be kept when only the generation changes")
+	}
+	if n := len(item.GetConditions()); n != 1 {
+		t.Errorf("expected a single condition, got %d", n)
+	}
+}
+
+var apiPkgPath = reflect.TypeOf(v1alpha1.ContentLibrary{}).PkgPath()
+
+// TestEveryKindHasConditions checks that every kind can report the Ready condition.
+func TestEveryKindHasConditions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	for gvk, typ := range scheme.AllKnownTypes() {
+		if gvk.GroupVersion() != v1alpha1.SchemeGroupVersion || typ.PkgPath() != apiPkgPath ||
+			strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		obj, err := scheme.New(gvk)
+		if err != nil {
+			t.Fatalf("failed to create a %s: %v", gvk.Kind, err)
+		}
+		if _, ok := obj.(conditions.Setter); !ok {
+			t.Errorf("%s has no conditions", gvk.Kind)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package conditions
+
+import (
+	"fmt"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Setter interface defines methods that an image registry object should implement in order to
+// use the conditions package for setting conditions.
+type Setter interface {
+	Getter
+	SetConditions(v1alpha1.Conditions)
+}
+
+// Set sets the given condition.
+//
+// NOTE: If a condition already exists, the LastTransitionTime is updated only if a change is detected
+// in the condition status.
+func Set(to Setter, condition *v1alpha1.Condition) {
+	if to == nil || condition == nil {
+		return
+	}
+
+	conditions := to.GetConditions()
+	for i := range conditions {
+		existing := &conditions[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		} else if condition.LastTransitionTime.IsZero() {
+			condition.LastTransitionTime = metav1.Now()
+		}
+		conditions[i] = *condition
+		to.SetConditions(conditions)
+		return
+	}
+
+	if condition.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = metav1.Now()
+	}
+	to.SetConditions(append(conditions, *condition))
+}
+
+// TrueCondition returns a condition with Status=True and the given type.
+func TrueCondition(t v1alpha1.ConditionType) *v1alpha1.Condition {
+	return &v1alpha1.Condition{
+		Type:   t,
+		Status: corev1.ConditionTrue,
+	}
+}
+
+// FalseCondition returns a condition with Status=False and the given type.
+func FalseCondition(t v1alpha1.ConditionType, reason string, severity v1alpha1.ConditionSeverity,
+	messageFormat string, messageArgs ...interface{}) *v1alpha1.Condition {
+	return &v1alpha1.Condition{
+		Type:     t,
+		Status:   corev1.ConditionFalse,
+		Reason:   reason,
+		Severity: severity,
+		Message:  fmt.Sprintf(messageFormat, messageArgs...),
+	}
+}
+
+// MarkTrue sets Status=True for the condition with the given type.
+func MarkTrue(to Setter, t v1alpha1.ConditionType) {
+	Set(to, TrueCondition(t))
+}
+
+// MarkFalse sets Status=False for the condition with the given type.
+func MarkFalse(to Setter, t v1alpha1.ConditionType, reason string, severity v1alpha1.ConditionSeverity,
+	messageFormat string, messageArgs ...interface{}) {
+	Set(to, FalseCondition(t, reason, severity, messageFormat, messageArgs...))
+}
+
+// Delete deletes the condition with the given type.
+func Delete(to Setter, t v1alpha1.ConditionType) {
+	if to == nil {
+		return
+	}
+
+	conditions := to.GetConditions()
+	newConditions := make(v1alpha1.Conditions, 0, len(conditions))
+	for _, condition := range conditions {
+		if condition.Type != t {
+			newConditions = append(newConditions, condition)
+		}
+	}
+	to.SetConditions(newConditions)
+}
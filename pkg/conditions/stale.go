@@ -0,0 +1,40 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package conditions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IsStale returns true if the resource was not observed by its controller within threshold of now.
+// A resource that was never observed is stale.
+func IsStale(lastObservedTime *metav1.Time, now time.Time, threshold time.Duration) bool {
+	return lastObservedTime == nil || now.Sub(lastObservedTime.Time) > threshold
+}
+
+// UpdateStale sets the Stale condition of the resource to True if it was not observed by its controller
+// within threshold of now, and to False otherwise. It returns true if the resource is stale.
+func UpdateStale(to Setter, lastObservedTime *metav1.Time, now time.Time, threshold time.Duration) bool {
+	if !IsStale(lastObservedTime, now, threshold) {
+		Set(to, &v1alpha1.Condition{Type: v1alpha1.ConditionStale, Status: corev1.ConditionFalse})
+		return false
+	}
+
+	message := "the resource was never observed by its controller"
+	if lastObservedTime != nil {
+		message = fmt.Sprintf("the resource was last observed by its controller at %s",
+			lastObservedTime.UTC().Format(time.RFC3339))
+	}
+	Set(to, &v1alpha1.Condition{
+		Type:    v1alpha1.ConditionStale,
+		Status:  corev1.ConditionTrue,
+		Reason:  v1alpha1.NotObservedReason,
+		Message: message,
+	})
+	return true
+}
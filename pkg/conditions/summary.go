@@ -0,0 +1,86 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package conditions
+
+import (
+	"fmt"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ReadySummary is the summary of an object that has no pending operation nor failing condition.
+	ReadySummary = "Ready"
+
+	// SyncingSummary is the summary of a library item whose content is being transferred.
+	SyncingSummary = "Syncing content"
+
+	// OrphanedSummary is the summary of a library item that was deleted in vCenter.
+	OrphanedSummary = "Orphaned"
+)
+
+// ReadyMessage returns a one-line human readable summary of the state of obj, derived from its conditions
+// and, for library items, from their phase and sync progress, e.g. "Ready", "Syncing content (82%)" or
+// "Error: publisher certificate expired". Controllers store it in the status.summary field of the
+// object so that it is displayed by kubectl.
+func ReadyMessage(obj Getter) string {
+	var phase v1alpha1.ContentLibraryItemPhase
+	var progress *v1alpha1.SyncProgress
+	switch item := obj.(type) {
+	case *v1alpha1.ContentLibraryItem:
+		phase, progress = item.Status.Phase, item.Status.SyncProgress
+	case *v1alpha1.ClusterContentLibraryItem:
+		phase, progress = item.Status.Phase, item.Status.SyncProgress
+	}
+
+	if phase == v1alpha1.ContentLibraryItemPhaseOrphaned {
+		return OrphanedSummary
+	}
+
+	if c := Get(obj, v1alpha1.ConditionStale); c != nil && c.Status == corev1.ConditionTrue {
+		return "Stale: " + describeCondition(c)
+	}
+
+	if c := firstFalse(obj, v1alpha1.ConditionSeverityError); c != nil {
+		return "Error: " + describeCondition(c)
+	}
+
+	if IsTrue(obj, v1alpha1.ContentLibraryItemConditionTransferring) {
+		if progress != nil {
+			return fmt.Sprintf("%s (%d%%)", SyncingSummary, progress.Percentage)
+		}
+		return SyncingSummary
+	}
+
+	if c := Get(obj, v1alpha1.ContentLibraryItemConditionDriftDetected); c != nil && c.Status == corev1.ConditionTrue {
+		return "Drift detected: " + describeCondition(c)
+	}
+
+	if c := firstFalse(obj, v1alpha1.ConditionSeverityWarning); c != nil {
+		return "Warning: " + describeCondition(c)
+	}
+
+	return ReadySummary
+}
+
+// firstFalse returns the first False condition of obj with the given severity, or nil if there is none.
+func firstFalse(obj Getter, severity v1alpha1.ConditionSeverity) *v1alpha1.Condition {
+	conditions := obj.GetConditions()
+	for i := range conditions {
+		if conditions[i].Status == corev1.ConditionFalse && conditions[i].Severity == severity {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+func describeCondition(c *v1alpha1.Condition) string {
+	if c.Message != "" {
+		return c.Message
+	}
+	if c.Reason != "" {
+		return c.Reason
+	}
+	return string(c.Type)
+}
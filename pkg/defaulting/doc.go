@@ -0,0 +1,6 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package defaulting contains the defaulting logic of the image registry mutating admission webhooks.
+// The functions do not depend on any webhook framework, so every component admitting these
+// resources can share them.
+package defaulting
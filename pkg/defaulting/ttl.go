@@ -0,0 +1,44 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package defaulting
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+)
+
+// The defaulting functions of the request kinds set Spec.TTLSecondsAfterFinished to
+// v1alpha1.DefaultTTLSecondsAfterFinished if it is unset, so that completed requests are garbage collected
+// instead of accumulating forever.
+
+// DefaultContentLibraryItemImportRequest sets the defaults of a ContentLibraryItemImportRequest on creation.
+func DefaultContentLibraryItemImportRequest(request *v1alpha1.ContentLibraryItemImportRequest) {
+	defaultTTLSecondsAfterFinished(&request.Spec.TTLSecondsAfterFinished)
+}
+
+// DefaultContentLibrarySyncRequest sets the defaults of a ContentLibrarySyncRequest on creation.
+func DefaultContentLibrarySyncRequest(request *v1alpha1.ContentLibrarySyncRequest) {
+	defaultTTLSecondsAfterFinished(&request.Spec.TTLSecondsAfterFinished)
+}
+
+// DefaultContentLibraryItemValidationRequest sets the defaults of a ContentLibraryItemValidationRequest on
+// creation.
+func DefaultContentLibraryItemValidationRequest(request *v1alpha1.ContentLibraryItemValidationRequest) {
+	defaultTTLSecondsAfterFinished(&request.Spec.TTLSecondsAfterFinished)
+}
+
+// DefaultContentLibraryItemFileUpload sets the defaults of a ContentLibraryItemFileUpload on creation.
+func DefaultContentLibraryItemFileUpload(upload *v1alpha1.ContentLibraryItemFileUpload) {
+	defaultTTLSecondsAfterFinished(&upload.Spec.TTLSecondsAfterFinished)
+}
+
+// DefaultContentLibraryItemVolumeRequest sets the defaults of a ContentLibraryItemVolumeRequest on creation.
+func DefaultContentLibraryItemVolumeRequest(request *v1alpha1.ContentLibraryItemVolumeRequest) {
+	defaultTTLSecondsAfterFinished(&request.Spec.TTLSecondsAfterFinished)
+}
+
+func defaultTTLSecondsAfterFinished(ttl **int32) {
+	if *ttl == nil {
+		value := v1alpha1.DefaultTTLSecondsAfterFinished
+		*ttl = &value
+	}
+}
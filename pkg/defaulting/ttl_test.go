@@ -0,0 +1,69 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package defaulting_test
+
+import (
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/defaulting"
+)
+
+func TestDefaultTTLSecondsAfterFinished(t *testing.T) {
+	ttl := func(value int32) *int32 { return &value }
+
+	// defaulters default the TTL of a new request of every request kind, and return a pointer to it.
+	defaulters := map[string]func(*int32) *int32{
+		"ContentLibraryItemImportRequest": func(value *int32) *int32 {
+			request := &v1alpha1.ContentLibraryItemImportRequest{}
+			request.Spec.TTLSecondsAfterFinished = value
+			defaulting.DefaultContentLibraryItemImportRequest(request)
+			return request.Spec.TTLSecondsAfterFinished
+		},
+		"ContentLibrarySyncRequest": func(value *int32) *int32 {
+			request := &v1alpha1.ContentLibrarySyncRequest{}
+			request.Spec.TTLSecondsAfterFinished = value
+			defaulting.DefaultContentLibrarySyncRequest(request)
+			return request.Spec.TTLSecondsAfterFinished
+		},
+		"ContentLibraryItemValidationRequest": func(value *int32) *int32 {
+			request := &v1alpha1.ContentLibraryItemValidationRequest{}
+			request.Spec.TTLSecondsAfterFinished = value
+			defaulting.DefaultContentLibraryItemValidationRequest(request)
+			return request.Spec.TTLSecondsAfterFinished
+		},
+		"ContentLibraryItemFileUpload": func(value *int32) *int32 {
+			upload := &v1alpha1.ContentLibraryItemFileUpload{}
+			upload.Spec.TTLSecondsAfterFinished = value
+			defaulting.DefaultContentLibraryItemFileUpload(upload)
+			return upload.Spec.TTLSecondsAfterFinished
+		},
+		"ContentLibraryItemVolumeRequest": func(value *int32) *int32 {
+			request := &v1alpha1.ContentLibraryItemVolumeRequest{}
+			request.Spec.TTLSecondsAfterFinished = value
+			defaulting.DefaultContentLibraryItemVolumeRequest(request)
+			return request.Spec.TTLSecondsAfterFinished
+		},
+	}
+
+	tests := []struct {
+		name     string
+		ttl      *int32
+		expected int32
+	}{
+		{name: "unset", expected: v1alpha1.DefaultTTLSecondsAfterFinished},
+		{name: "zero is kept", ttl: ttl(0), expected: 0},
+		{name: "an explicit TTL is kept", ttl: ttl(60), expected: 60},
+	}
+
+	for kind, defaulter := range defaulters {
+		for _, tt := range tests {
+			t.Run(kind+"/"+tt.name, func(t *testing.T) {
+				actual := defaulter(tt.ttl)
+				if actual == nil || *actual != tt.expected {
+					t.Errorf("expected the TTL %d, got %v", tt.expected, actual)
+				}
+			})
+		}
+	}
+}
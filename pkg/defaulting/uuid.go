@@ -0,0 +1,32 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package defaulting
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+)
+
+// The defaulting functions normalize the vCenter UUIDs of the resources with v1alpha1.NormalizeUUID when they
+// are created, so that UUIDs copied with a different casing or surrounding whitespace do not create
+// duplicate resources for the same vCenter object. They are not applied on update since the UUIDs are
+// immutable.
+
+// DefaultContentLibrary sets the defaults of a ContentLibrary on creation.
+func DefaultContentLibrary(library *v1alpha1.ContentLibrary) {
+	library.Spec.UUID = library.Spec.UUID.Normalize()
+}
+
+// DefaultClusterContentLibrary sets the defaults of a ClusterContentLibrary on creation.
+func DefaultClusterContentLibrary(library *v1alpha1.ClusterContentLibrary) {
+	library.Spec.UUID = library.Spec.UUID.Normalize()
+}
+
+// DefaultContentLibraryItem sets the defaults of a ContentLibraryItem on creation.
+func DefaultContentLibraryItem(item *v1alpha1.ContentLibraryItem) {
+	item.Spec.UUID = item.Spec.UUID.Normalize()
+}
+
+// DefaultClusterContentLibraryItem sets the defaults of a ClusterContentLibraryItem on creation.
+func DefaultClusterContentLibraryItem(item *v1alpha1.ClusterContentLibraryItem) {
+	item.Spec.UUID = item.Spec.UUID.Normalize()
+}
@@ -0,0 +1,6 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package examples constructs canonical, valid objects of the image registry API, so that downstream
+// projects have compile-checked object shapes to start from. Every function returns a new object that can be
+// modified freely by the caller.
+package examples
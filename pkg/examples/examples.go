@@ -0,0 +1,405 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package examples
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The identifiers used by the examples.
+const (
+	Namespace       = "my-namespace"
+	LibraryUUID     = "3f9b9d2e-5c1a-4d8e-9f2b-1a2b3c4d5e6f"
+	ItemUUID        = "8c2d4e6f-1a3b-4c5d-8e9f-0a1b2c3d4e5f"
+	SubscriptionURL = "https://publisher.example.com:443/cls/vcsp/lib/6ae2f2b4-0b1e-4f0c-9d8a-7c6b5a4f3e2d/lib.json"
+)
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: kind}
+}
+
+// AdoptedContentLibrary returns a ContentLibrary that adopts an existing vCenter library in read-only mode.
+func AdoptedContentLibrary() *v1alpha1.ContentLibrary {
+	return &v1alpha1.ContentLibrary{
+		TypeMeta:   typeMeta("ContentLibrary"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "adopted-library"},
+		Spec: v1alpha1.ContentLibrarySpec{
+			UUID: LibraryUUID,
+		},
+	}
+}
+
+// WritableContentLibrary returns a ContentLibrary that has the operator create a writable local library
+// on a datastore selected by the storage policy of a StorageClass.
+func WritableContentLibrary() *v1alpha1.ContentLibrary {
+	return &v1alpha1.ContentLibrary{
+		TypeMeta:   typeMeta("ContentLibrary"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "writable-library"},
+		Spec: v1alpha1.ContentLibrarySpec{
+			Create: &v1alpha1.ContentLibraryCreateSpec{
+				Name:        "writable-library",
+				Description: "Images uploaded by the users of my-namespace",
+				Type:        v1alpha1.ContentLibraryTypeLocal,
+			},
+			Writable:         true,
+			StorageClassName: "wcp-storage-policy",
+		},
+	}
+}
+
+// PublishedContentLibrary returns a writable ContentLibrary that is published with basic authentication.
+// The password is read from the "password" key of the "publish-password" Secret.
+func PublishedContentLibrary() *v1alpha1.ContentLibrary {
+	library := WritableContentLibrary()
+	library.Name = "published-library"
+	library.Spec.Create.Name = "published-library"
+	library.Spec.Publish = &v1alpha1.PublishSpec{
+		Enabled: true,
+		Authentication: &v1alpha1.PublishAuthentication{
+			Method:            v1alpha1.PublishAuthenticationMethodBasic,
+			PasswordSecretRef: &corev1.LocalObjectReference{Name: "publish-password"},
+		},
+	}
+	return library
+}
+
+// SubscribedContentLibrary returns a ContentLibrary that has the operator create an on-demand subscribed
+// library, reaching the publisher through an authenticated proxy.
+func SubscribedContentLibrary() *v1alpha1.ContentLibrary {
+	return &v1alpha1.ContentLibrary{
+		TypeMeta:   typeMeta("ContentLibrary"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "subscribed-library"},
+		Spec: v1alpha1.ContentLibrarySpec{
+			Create: &v1alpha1.ContentLibraryCreateSpec{
+				Name: "subscribed-library",
+				Type: v1alpha1.ContentLibraryTypeSubscribed,
+			},
+			Subscription: &v1alpha1.SubscriptionSpec{
+				SubscriptionURL:      SubscriptionURL,
+				OnDemand:             true,
+				AutomaticSyncEnabled: true,
+				PasswordSecretRef:    &corev1.LocalObjectReference{Name: "subscription-password"},
+			},
+			Proxy: &v1alpha1.ProxyConfiguration{
+				HTTPSProxy:           "http://proxy.example.com:3128",
+				NoProxy:              []string{".cluster.local"},
+				CredentialsSecretRef: &corev1.SecretReference{Name: "proxy-credentials"},
+			},
+		},
+	}
+}
+
+// ClusterContentLibrary returns a ClusterContentLibrary that adopts an existing vCenter library.
+func ClusterContentLibrary() *v1alpha1.ClusterContentLibrary {
+	return &v1alpha1.ClusterContentLibrary{
+		TypeMeta:   typeMeta("ClusterContentLibrary"),
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-library"},
+		Spec: v1alpha1.ClusterContentLibrarySpec{
+			UUID: LibraryUUID,
+		},
+	}
+}
+
+// WritableClusterContentLibrary returns a writable ClusterContentLibrary that platform teams publish shared
+// golden images to, by importing them from the namespaces labeled "team=platform".
+func WritableClusterContentLibrary() *v1alpha1.ClusterContentLibrary {
+	return &v1alpha1.ClusterContentLibrary{
+		TypeMeta:   typeMeta("ClusterContentLibrary"),
+		ObjectMeta: metav1.ObjectMeta{Name: "golden-images"},
+		Spec: v1alpha1.ClusterContentLibrarySpec{
+			UUID:     LibraryUUID,
+			Writable: true,
+			AllowedNamespaces: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "platform"},
+			},
+		},
+	}
+}
+
+// OvfContentLibraryItem returns a ready OVF ContentLibraryItem of AdoptedContentLibrary, with the status the
+// operator reports for it.
+func OvfContentLibraryItem() *v1alpha1.ContentLibraryItem {
+	memory := resource.MustParse("2Gi")
+	return &v1alpha1.ContentLibraryItem{
+		TypeMeta: typeMeta("ContentLibraryItem"),
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: Namespace,
+			Name:      v1alpha1.NameFromUUID(ItemUUID, v1alpha1.ContentLibraryItemNamePrefix),
+		},
+		Spec: v1alpha1.ContentLibraryItemSpec{
+			UUID: ItemUUID,
+		},
+		Status: v1alpha1.ContentLibraryItemStatus{
+			Name:              "ubuntu-22.04",
+			ContentLibraryRef: v1alpha1.ContentLibraryReference{Name: "adopted-library", Namespace: Namespace},
+			SourceLibraryType: v1alpha1.ContentLibraryTypeLocal,
+			MetadataVersion:   "1",
+			ContentVersion:    "2",
+			Type:              v1alpha1.ContentLibraryItemTypeOvf,
+			Files: []v1alpha1.FileInfo{
+				{Name: "ubuntu-22.04.ovf", Size: 8192, Version: "2", Cached: true},
+				{Name: "ubuntu-22.04-disk1.vmdk", Size: 640 << 20, Version: "2", Cached: true},
+			},
+			DeploymentDefaults: &v1alpha1.DeploymentDefaults{
+				Networks:  []string{"nic0"},
+				MinCPUs:   2,
+				MinMemory: &memory,
+				GuestOSID: "ubuntu64Guest",
+			},
+			HardwareVersion: 19,
+			Phase:           v1alpha1.ContentLibraryItemPhaseAvailable,
+			Cached:          true,
+			CacheStatus:     v1alpha1.CacheStatusCached,
+			Ready:           true,
+		},
+	}
+}
+
+// ClusterContentLibraryItem returns a ready ISO ClusterContentLibraryItem of ClusterContentLibrary, with the
+// status the operator reports for it.
+func ClusterContentLibraryItem() *v1alpha1.ClusterContentLibraryItem {
+	return &v1alpha1.ClusterContentLibraryItem{
+		TypeMeta: typeMeta("ClusterContentLibraryItem"),
+		ObjectMeta: metav1.ObjectMeta{
+			Name: v1alpha1.NameFromUUID(ItemUUID, v1alpha1.ClusterContentLibraryItemNamePrefix),
+		},
+		Spec: v1alpha1.ClusterContentLibraryItemSpec{
+			UUID: ItemUUID,
+		},
+		Status: v1alpha1.ClusterContentLibraryItemStatus{
+			Name:                     "photon-5-installer",
+			ClusterContentLibraryRef: "cluster-library",
+			SourceLibraryType:        v1alpha1.ContentLibraryTypeLocal,
+			MetadataVersion:          "1",
+			ContentVersion:           "1",
+			Type:                     v1alpha1.ContentLibraryItemTypeIso,
+			Files: []v1alpha1.FileInfo{
+				{Name: "photon-5.iso", Size: 512 << 20, Version: "1", Cached: true},
+			},
+			Cached:      true,
+			CacheStatus: v1alpha1.CacheStatusCached,
+			Ready:       true,
+		},
+	}
+}
+
+// ContentLibraryItemVersion returns the ContentLibraryItemVersion the operator records for the current content
+// version of OvfContentLibraryItem.
+func ContentLibraryItemVersion() *v1alpha1.ContentLibraryItemVersion {
+	itemName := v1alpha1.NameFromUUID(ItemUUID, v1alpha1.ContentLibraryItemNamePrefix)
+	return &v1alpha1.ContentLibraryItemVersion{
+		TypeMeta: typeMeta("ContentLibraryItemVersion"),
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: Namespace,
+			Name:      v1alpha1.ContentLibraryItemVersionName(itemName, "2"),
+		},
+		Spec: v1alpha1.ContentLibraryItemVersionSpec{
+			ContentLibraryItemRef: itemName,
+			ContentVersion:        "2",
+		},
+		Status: v1alpha1.ContentLibraryItemVersionStatus{
+			UUID: ItemUUID,
+			Type: v1alpha1.ContentLibraryItemTypeOvf,
+			Files: []v1alpha1.FileInfo{
+				{Name: "ubuntu-22.04.ovf", Size: 8192, Version: "2", Cached: true},
+				{Name: "ubuntu-22.04-disk1.vmdk", Size: 640 << 20, Version: "2", Cached: true},
+			},
+			PreviousContentVersion: "1",
+			Changes: []v1alpha1.FileChange{
+				{Name: "ubuntu-22.04-disk1.vmdk", Type: v1alpha1.FileChangeTypeChanged},
+			},
+			Current: true,
+		},
+	}
+}
+
+// ContentLibraryItemSummary returns the ContentLibraryItemSummary the operator maintains for
+// AdoptedContentLibrary.
+func ContentLibraryItemSummary() *v1alpha1.ContentLibraryItemSummary {
+	return &v1alpha1.ContentLibraryItemSummary{
+		TypeMeta:   typeMeta("ContentLibraryItemSummary"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "adopted-library"},
+		Status: v1alpha1.ItemSummaryStatus{
+			TotalItems: 1,
+			ReadyItems: 1,
+			Items: []v1alpha1.ItemDigest{
+				{Name: "ubuntu-22.04", UUID: ItemUUID, Ready: true, ContentVersion: "2"},
+			},
+		},
+	}
+}
+
+// ClusterContentLibraryItemSummary returns the ClusterContentLibraryItemSummary the operator maintains for
+// ClusterContentLibrary.
+func ClusterContentLibraryItemSummary() *v1alpha1.ClusterContentLibraryItemSummary {
+	return &v1alpha1.ClusterContentLibraryItemSummary{
+		TypeMeta:   typeMeta("ClusterContentLibraryItemSummary"),
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-library"},
+		Status: v1alpha1.ItemSummaryStatus{
+			TotalItems: 1,
+			ReadyItems: 1,
+			Items: []v1alpha1.ItemDigest{
+				{Name: "photon-5-installer", UUID: ItemUUID, Ready: true, ContentVersion: "1"},
+			},
+		},
+	}
+}
+
+// ContentLibraryItemFileUpload returns a ContentLibraryItemFileUpload that pulls an OVA into a library item
+// of a writable library.
+func ContentLibraryItemFileUpload() *v1alpha1.ContentLibraryItemFileUpload {
+	return &v1alpha1.ContentLibraryItemFileUpload{
+		TypeMeta:   typeMeta("ContentLibraryItemFileUpload"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "upload-photon"},
+		Spec: v1alpha1.ContentLibraryItemFileUploadSpec{
+			ContentLibraryItemRef: "photon-5",
+			Files: []v1alpha1.FileUpload{{
+				Name:   "photon-5.ova",
+				Source: v1alpha1.FileUploadSource{HTTP: &v1alpha1.HTTPFileSource{URL: "https://images.example.com/photon-5.ova"}},
+			}},
+		},
+	}
+}
+
+// ContentLibraryItemImportRequest returns a ContentLibraryItemImportRequest that imports an ISO image into
+// WritableContentLibrary.
+func ContentLibraryItemImportRequest() *v1alpha1.ContentLibraryItemImportRequest {
+	return &v1alpha1.ContentLibraryItemImportRequest{
+		TypeMeta:   typeMeta("ContentLibraryItemImportRequest"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "import-installer"},
+		Spec: v1alpha1.ContentLibraryItemImportRequestSpec{
+			Source: v1alpha1.ContentLibraryItemImportRequestSource{URL: "https://images.example.com/installer.iso"},
+			Target: v1alpha1.ContentLibraryItemImportRequestTarget{
+				ContentLibraryRef: "writable-library",
+				ItemName:          "installer",
+				ItemType:          v1alpha1.ContentLibraryItemTypeIso,
+			},
+		},
+	}
+}
+
+// ContentLibraryImportSet returns a ContentLibraryImportSet that imports the images listed in the "images"
+// key of a ConfigMap into WritableContentLibrary.
+func ContentLibraryImportSet() *v1alpha1.ContentLibraryImportSet {
+	return &v1alpha1.ContentLibraryImportSet{
+		TypeMeta:   typeMeta("ContentLibraryImportSet"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "golden-images"},
+		Spec: v1alpha1.ContentLibraryImportSetSpec{
+			Manifest: v1alpha1.ImportSetManifestSource{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "golden-images"},
+					Key:                  "images",
+				},
+			},
+			ContentLibraryRef: "writable-library",
+		},
+	}
+}
+
+// ImageAlias returns an ImageAlias that resolves to OvfContentLibraryItem.
+func ImageAlias() *v1alpha1.ImageAlias {
+	return &v1alpha1.ImageAlias{
+		TypeMeta:   typeMeta("ImageAlias"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "ubuntu-latest"},
+		Spec: v1alpha1.ImageAliasSpec{
+			Target: v1alpha1.ImageAliasTarget{
+				Kind: "ContentLibraryItem",
+				Name: v1alpha1.NameFromUUID(ItemUUID, v1alpha1.ContentLibraryItemNamePrefix),
+			},
+		},
+	}
+}
+
+// ImageFamily returns an ImageFamily that resolves to the latest ready Ubuntu 22.04 item of
+// AdoptedContentLibrary.
+func ImageFamily() *v1alpha1.ImageFamily {
+	return &v1alpha1.ImageFamily{
+		TypeMeta:   typeMeta("ImageFamily"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "ubuntu-22.04"},
+		Spec: v1alpha1.ImageFamilySpec{
+			ItemKind:    "ContentLibraryItem",
+			NamePattern: "ubuntu-22.04*",
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{v1alpha1.LibraryNameLabelKey: "adopted-library"},
+			},
+		},
+	}
+}
+
+// ContentLibraryReplication returns a ContentLibraryReplication that replicates the Ubuntu images of
+// ClusterContentLibrary into WritableContentLibrary every night.
+func ContentLibraryReplication() *v1alpha1.ContentLibraryReplication {
+	return &v1alpha1.ContentLibraryReplication{
+		TypeMeta:   typeMeta("ContentLibraryReplication"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "ubuntu-images"},
+		Spec: v1alpha1.ContentLibraryReplicationSpec{
+			Source: v1alpha1.ReplicationSource{
+				Kind: "ClusterContentLibrary",
+				Name: "cluster-library",
+				ItemSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{v1alpha1.ItemTypeLabelKey: string(v1alpha1.ContentLibraryItemTypeOvf)},
+				},
+			},
+			DestinationRef: "writable-library",
+			Schedule:       "0 2 * * *",
+		},
+	}
+}
+
+// ContentLibraryItemValidationRequest returns a ContentLibraryItemValidationRequest that validates
+// OvfContentLibraryItem.
+func ContentLibraryItemValidationRequest() *v1alpha1.ContentLibraryItemValidationRequest {
+	return &v1alpha1.ContentLibraryItemValidationRequest{
+		TypeMeta:   typeMeta("ContentLibraryItemValidationRequest"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "validate-ubuntu"},
+		Spec: v1alpha1.ContentLibraryItemValidationRequestSpec{
+			ItemRef: v1alpha1.LibraryItemReference{
+				Kind: "ContentLibraryItem",
+				Name: v1alpha1.NameFromUUID(ItemUUID, v1alpha1.ContentLibraryItemNamePrefix),
+			},
+		},
+	}
+}
+
+// ContentLibraryItemVolumeRequest returns a ContentLibraryItemVolumeRequest that materializes the disk of
+// OvfContentLibraryItem into a PersistentVolumeClaim.
+func ContentLibraryItemVolumeRequest() *v1alpha1.ContentLibraryItemVolumeRequest {
+	return &v1alpha1.ContentLibraryItemVolumeRequest{
+		TypeMeta:   typeMeta("ContentLibraryItemVolumeRequest"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "ubuntu-disk"},
+		Spec: v1alpha1.ContentLibraryItemVolumeRequestSpec{
+			ItemRef: v1alpha1.LibraryItemReference{
+				Kind: "ContentLibraryItem",
+				Name: v1alpha1.NameFromUUID(ItemUUID, v1alpha1.ContentLibraryItemNamePrefix),
+			},
+			FileName:         "ubuntu-22.04-disk1.vmdk",
+			StorageClassName: "wcp-storage-policy",
+		},
+	}
+}
+
+// ContentLibrarySyncRequest returns a ContentLibrarySyncRequest that synchronizes SubscribedContentLibrary.
+func ContentLibrarySyncRequest() *v1alpha1.ContentLibrarySyncRequest {
+	return &v1alpha1.ContentLibrarySyncRequest{
+		TypeMeta:   typeMeta("ContentLibrarySyncRequest"),
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: "sync-subscribed-library"},
+		Spec: v1alpha1.ContentLibrarySyncRequestSpec{
+			ContentLibraryRef: "subscribed-library",
+		},
+	}
+}
+
+// ContentLibraryConfiguration returns the ContentLibraryConfiguration singleton with a few settings overridden.
+func ContentLibraryConfiguration() *v1alpha1.ContentLibraryConfiguration {
+	return &v1alpha1.ContentLibraryConfiguration{
+		TypeMeta:   typeMeta("ContentLibraryConfiguration"),
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ContentLibraryConfigurationName},
+		Spec: v1alpha1.ContentLibraryConfigurationSpec{
+			MaxConcurrentSyncs: 4,
+			FeatureGates:       map[string]bool{string(v1alpha1.FeatureItemImport): true},
+		},
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package examples_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/examples"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/openapi/openapitest"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/validation"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+// all are the examples of the package, by the name of their function.
+var all = map[string]func() runtime.Object{
+	"AdoptedContentLibrary":               func() runtime.Object { return examples.AdoptedContentLibrary() },
+	"WritableContentLibrary":              func() runtime.Object { return examples.WritableContentLibrary() },
+	"PublishedContentLibrary":             func() runtime.Object { return examples.PublishedContentLibrary() },
+	"SubscribedContentLibrary":            func() runtime.Object { return examples.SubscribedContentLibrary() },
+	"ClusterContentLibrary":               func() runtime.Object { return examples.ClusterContentLibrary() },
+	"WritableClusterContentLibrary":       func() runtime.Object { return examples.WritableClusterContentLibrary() },
+	"OvfContentLibraryItem":               func() runtime.Object { return examples.OvfContentLibraryItem() },
+	"ClusterContentLibraryItem":           func() runtime.Object { return examples.ClusterContentLibraryItem() },
+	"ContentLibraryItemVersion":           func() runtime.Object { return examples.ContentLibraryItemVersion() },
+	"ContentLibraryItemSummary":           func() runtime.Object { return examples.ContentLibraryItemSummary() },
+	"ClusterContentLibraryItemSummary":    func() runtime.Object { return examples.ClusterContentLibraryItemSummary() },
+	"ContentLibraryItemFileUpload":        func() runtime.Object { return examples.ContentLibraryItemFileUpload() },
+	"ContentLibraryItemImportRequest":     func() runtime.Object { return examples.ContentLibraryItemImportRequest() },
+	"ContentLibraryImportSet":             func() runtime.Object { return examples.ContentLibraryImportSet() },
+	"ImageAlias":                          func() runtime.Object { return examples.ImageAlias() },
+	"ImageFamily":                         func() runtime.Object { return examples.ImageFamily() },
+	"ContentLibraryReplication":           func() runtime.Object { return examples.ContentLibraryReplication() },
+	"ContentLibraryItemValidationRequest": func() runtime.Object { return examples.ContentLibraryItemValidationRequest() },
+	"ContentLibraryItemVolumeRequest":     func() runtime.Object { return examples.ContentLibraryItemVolumeRequest() },
+	"ContentLibrarySyncRequest":           func() runtime.Object { return examples.ContentLibrarySyncRequest() },
+	"ContentLibraryConfiguration":         func() runtime.Object { return examples.ContentLibraryConfiguration() },
+}
+
+func TestExamplesCoverEveryKind(t *testing.T) {
+	covered := map[string]bool{}
+	for _, example := range all {
+		covered[example().GetObjectKind().GroupVersionKind().Kind] = true
+	}
+
+	pkgPath := reflect.TypeOf(v1alpha1.ContentLibrary{}).PkgPath()
+	for gvk, typ := range openapitest.Scheme().AllKnownTypes() {
+		if gvk.GroupVersion() != v1alpha1.SchemeGroupVersion || typ.PkgPath() != pkgPath ||
+			strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		if !covered[gvk.Kind] {
+			t.Errorf("there is no example of %s", gvk.Kind)
+		}
+	}
+}
+
+func TestExamplesMatchSchemas(t *testing.T) {
+	for name, example := range all {
+		t.Run(name, func(t *testing.T) {
+			obj := example()
+			if kinds, _, err := openapitest.Scheme().ObjectKinds(obj); err != nil || kinds[0] != obj.GetObjectKind().GroupVersionKind() {
+				t.Errorf("the type meta %v does not match the kind of the object %v (%v)",
+					obj.GetObjectKind().GroupVersionKind(), kinds, err)
+			}
+
+			errs, err := openapitest.Validate(obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(errs) > 0 {
+				t.Errorf("the example is not valid against its schema: %v", errs.ToAggregate())
+			}
+
+			into := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(runtime.Object)
+			pruned, err := openapitest.RoundTrip(obj, into)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(pruned) > 0 {
+				t.Errorf("the fields %v of the example are not declared by its schema", pruned)
+			}
+			if !apiequality.Semantic.DeepEqual(obj, into) {
+				t.Errorf("the example changed in the round-trip: %s", diff.ObjectReflectDiff(obj, into))
+			}
+		})
+	}
+}
+
+func TestExamplesPassValidation(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: examples.Namespace, Labels: map[string]string{"team": "platform"}},
+	}
+
+	for name, example := range all {
+		t.Run(name, func(t *testing.T) {
+			var errs field.ErrorList
+			switch obj := example().(type) {
+			case *v1alpha1.ContentLibrary:
+				errs = validation.ValidateContentLibrary(obj)
+			case *v1alpha1.ClusterContentLibrary:
+				errs = validation.ValidateClusterContentLibrary(obj)
+			case *v1alpha1.ContentLibraryItem:
+				errs = validation.ValidateContentLibraryItem(obj, examples.AdoptedContentLibrary())
+			case *v1alpha1.ClusterContentLibraryItem:
+				errs = validation.ValidateClusterContentLibraryItem(obj, examples.ClusterContentLibrary())
+			case *v1alpha1.ContentLibraryItemImportRequest:
+				errs = validation.ValidateContentLibraryItemImportRequest(obj, nil, namespace)
+			case *v1alpha1.ContentLibraryImportSet:
+				errs = validation.ValidateContentLibraryImportSet(obj)
+			case *v1alpha1.ImageAlias:
+				errs = validation.ValidateImageAlias(obj, examples.OvfContentLibraryItem(), nil)
+			case *v1alpha1.ImageFamily:
+				errs = validation.ValidateImageFamily(obj)
+			case *v1alpha1.ContentLibraryConfiguration:
+				errs = validation.ValidateContentLibraryConfiguration(obj)
+			}
+			if len(errs) > 0 {
+				t.Errorf("the example does not pass validation: %v", errs.ToAggregate())
+			}
+		})
+	}
+}
+
+func ExampleAdoptedContentLibrary() {
+	data, err := yaml.Marshal(examples.AdoptedContentLibrary().Spec)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(string(data))
+	// Output:
+	// uuid: 3f9b9d2e-5c1a-4d8e-9f2b-1a2b3c4d5e6f
+	// writable: false
+}
+
+func ExampleWritableContentLibrary() {
+	library := examples.WritableContentLibrary()
+	library.Spec.Create.Description = "Images built by the CI pipeline"
+	fmt.Println(library.Spec.Create.Type, library.Spec.Writable)
+	// Output: Local true
+}
+
+func ExampleOvfContentLibraryItem() {
+	item := examples.OvfContentLibraryItem()
+	fmt.Println(item.Name, item.Status.Type, item.Status.HardwareVersion)
+	// Output: clitem-bb5032445b7330733 Ovf 19
+}
+
+func ExampleContentLibraryItemVersion() {
+	version := examples.ContentLibraryItemVersion()
+	fmt.Println(version.Name)
+	// Output: clitem-bb5032445b7330733-v2
+}
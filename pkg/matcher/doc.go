@@ -0,0 +1,7 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package matcher selects library items by the capabilities reported in their status, so that schedulers
+// and user interfaces share the same rules to find an image capable of running a given workload. It also
+// resolves the members and the latest ready item of an ImageFamily, so that every controller resolves a
+// family to the same library item.
+package matcher
@@ -0,0 +1,90 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package matcher
+
+import (
+	"path"
+	"time"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// FamilyMembers returns the items that are members of the ImageFamily, in their original order.
+// ContentLibraryItems are members only if they are in the namespace of the family.
+func FamilyMembers(items []Item, family *v1alpha1.ImageFamily) ([]Item, error) {
+	selector := labels.Everything()
+	if family.Spec.Selector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(family.Spec.Selector); err != nil {
+			return nil, err
+		}
+	}
+
+	var members []Item
+	for _, item := range items {
+		status, ok := statusOf(item)
+		if !ok || kindOf(item) != family.Spec.ItemKind {
+			continue
+		}
+		if family.Spec.ItemKind == "ContentLibraryItem" && item.GetNamespace() != family.Namespace {
+			continue
+		}
+		if family.Spec.NamePattern != "" {
+			matched, err := path.Match(family.Spec.NamePattern, status.name)
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				continue
+			}
+		}
+		if !selector.Matches(labels.Set(item.GetLabels())) {
+			continue
+		}
+		members = append(members, item)
+	}
+	return members, nil
+}
+
+// LatestReady returns the ready item that was created last in vCenter, or nil if no item is ready.
+// Items created at the same time are ordered by their name in vCenter, so the result does not depend on the
+// order of the items.
+func LatestReady(items []Item) Item {
+	var latest Item
+	var latestStatus itemStatus
+	var latestTime time.Time
+
+	for _, item := range items {
+		status, ok := statusOf(item)
+		if !ok || !status.ready {
+			continue
+		}
+		created := creationTimeOf(item, status)
+		if latest == nil || created.After(latestTime) ||
+			(created.Equal(latestTime) && status.name > latestStatus.name) {
+			latest, latestStatus, latestTime = item, status, created
+		}
+	}
+	return latest
+}
+
+// creationTimeOf returns the time the item was created in vCenter, or the time its resource was created if
+// the former cannot be parsed.
+func creationTimeOf(item Item, status itemStatus) time.Time {
+	if created, err := time.Parse(time.RFC3339, status.creationTime); err == nil {
+		return created
+	}
+	return item.GetCreationTimestamp().Time
+}
+
+func kindOf(item Item) string {
+	switch item.(type) {
+	case *v1alpha1.ContentLibraryItem:
+		return "ContentLibraryItem"
+	case *v1alpha1.ClusterContentLibraryItem:
+		return "ClusterContentLibraryItem"
+	}
+	return ""
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package matcher
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Item is a ContentLibraryItem or a ClusterContentLibraryItem.
+type Item interface {
+	metav1.Object
+	GetConditions() v1alpha1.Conditions
+}
+
+// Requirements describes the capabilities an image must have. Unset fields match every library item.
+type Requirements struct {
+	// Type is the required type of the library item.
+	Type v1alpha1.ContentLibraryItemType
+
+	// OSFamily is the required family of the guest operating system. It is matched against the guest OS of
+	// OVF items, and against the OS hints of ISO items.
+	OSFamily OSFamily
+
+	// MinHWVersion is the lowest acceptable virtual hardware version of OVF items. Items of other types have no
+	// hardware version and are not filtered by it.
+	MinHWVersion int32
+
+	// RequiredProperties are the OVF properties that must be settable at deployment time.
+	RequiredProperties []string
+
+	// Ready requires the library item to be ready to be used.
+	Ready bool
+}
+
+// Match returns the items that meet the requirements, in their original order.
+// Items that are neither a ContentLibraryItem nor a ClusterContentLibraryItem never match.
+func Match(items []Item, requirements Requirements) []Item {
+	var matches []Item
+	for _, item := range items {
+		if Matches(item, requirements) {
+			matches = append(matches, item)
+		}
+	}
+	return matches
+}
+
+// Matches returns true if the item meets the requirements.
+func Matches(item Item, requirements Requirements) bool {
+	status, ok := statusOf(item)
+	if !ok {
+		return false
+	}
+
+	if requirements.Type != "" && status.itemType != requirements.Type {
+		return false
+	}
+	if requirements.Ready && !status.ready {
+		return false
+	}
+	if requirements.MinHWVersion > 0 && status.itemType == v1alpha1.ContentLibraryItemTypeOvf &&
+		status.hardwareVersion < requirements.MinHWVersion {
+		return false
+	}
+	if requirements.OSFamily != "" && !matchesOSFamily(status, requirements.OSFamily) {
+		return false
+	}
+	return hasProperties(status.deploymentDefaults, requirements.RequiredProperties)
+}
+
+// itemStatus is the part of the status of ContentLibraryItem and ClusterContentLibraryItem that items are
+// matched against.
+type itemStatus struct {
+	name               string
+	creationTime       string
+	itemType           v1alpha1.ContentLibraryItemType
+	ready              bool
+	hardwareVersion    int32
+	deploymentDefaults *v1alpha1.DeploymentDefaults
+	isoInfo            *v1alpha1.IsoInfo
+}
+
+func statusOf(item Item) (itemStatus, bool) {
+	switch obj := item.(type) {
+	case *v1alpha1.ContentLibraryItem:
+		return itemStatus{
+			name:               obj.Status.Name,
+			creationTime:       obj.Status.CreationTime,
+			itemType:           obj.Status.Type,
+			ready:              obj.Status.Ready,
+			hardwareVersion:    obj.Status.HardwareVersion,
+			deploymentDefaults: obj.Status.DeploymentDefaults,
+			isoInfo:            obj.Status.IsoInfo,
+		}, true
+	case *v1alpha1.ClusterContentLibraryItem:
+		return itemStatus{
+			name:               obj.Status.Name,
+			creationTime:       obj.Status.CreationTime,
+			itemType:           obj.Status.Type,
+			ready:              obj.Status.Ready,
+			hardwareVersion:    obj.Status.HardwareVersion,
+			deploymentDefaults: obj.Status.DeploymentDefaults,
+			isoInfo:            obj.Status.IsoInfo,
+		}, true
+	}
+	return itemStatus{}, false
+}
+
+func matchesOSFamily(status itemStatus, family OSFamily) bool {
+	if status.deploymentDefaults != nil && GuestOSFamily(status.deploymentDefaults.GuestOSID) == family {
+		return true
+	}
+	if status.isoInfo != nil {
+		for _, hint := range status.isoInfo.OSHints {
+			if GuestOSFamily(hint) == family {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasProperties(defaults *v1alpha1.DeploymentDefaults, required []string) bool {
+	if len(required) == 0 {
+		return true
+	}
+	if defaults == nil {
+		return false
+	}
+
+	available := make(map[string]struct{}, len(defaults.Properties))
+	for _, property := range defaults.Properties {
+		available[property] = struct{}{}
+	}
+	for _, property := range required {
+		if _, ok := available[property]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package matcher_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/matcher"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testItems returns library items covering every type, with and without the optional parts of the status.
+func testItems() []matcher.Item {
+	return []matcher.Item{
+		&v1alpha1.ContentLibraryItem{
+			ObjectMeta: metav1.ObjectMeta{Name: "ubuntu-vmx19"},
+			Status: v1alpha1.ContentLibraryItemStatus{
+				Type:            v1alpha1.ContentLibraryItemTypeOvf,
+				Ready:           true,
+				HardwareVersion: 19,
+				DeploymentDefaults: &v1alpha1.DeploymentDefaults{
+					GuestOSID:  "ubuntu64Guest",
+					Properties: []string{"hostname", "user-data"},
+				},
+			},
+		},
+		&v1alpha1.ClusterContentLibraryItem{
+			ObjectMeta: metav1.ObjectMeta{Name: "windows-vmx13"},
+			Status: v1alpha1.ClusterContentLibraryItemStatus{
+				Type:            v1alpha1.ContentLibraryItemTypeOvf,
+				HardwareVersion: 13,
+				DeploymentDefaults: &v1alpha1.DeploymentDefaults{
+					GuestOSID:  "windows2019srv_64Guest",
+					Properties: []string{"hostname"},
+				},
+			},
+		},
+		&v1alpha1.ContentLibraryItem{
+			ObjectMeta: metav1.ObjectMeta{Name: "photon-iso"},
+			Status: v1alpha1.ContentLibraryItemStatus{
+				Type:    v1alpha1.ContentLibraryItemTypeIso,
+				Ready:   true,
+				IsoInfo: &v1alpha1.IsoInfo{OSHints: []string{"otherGuest", "vmwarePhoton64Guest"}},
+			},
+		},
+		&v1alpha1.ContentLibraryItem{
+			ObjectMeta: metav1.ObjectMeta{Name: "ovf-without-defaults"},
+			Status: v1alpha1.ContentLibraryItemStatus{
+				Type:  v1alpha1.ContentLibraryItemTypeOvf,
+				Ready: true,
+			},
+		},
+		&v1alpha1.ClusterContentLibraryItem{
+			ObjectMeta: metav1.ObjectMeta{Name: "empty-status"},
+		},
+	}
+}
+
+func names(items []matcher.Item) []string {
+	var names []string
+	for _, item := range items {
+		names = append(names, item.GetName())
+	}
+	return names
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		requirements matcher.Requirements
+		expected     []string
+	}{
+		{
+			name:     "no requirements",
+			expected: []string{"ubuntu-vmx19", "windows-vmx13", "photon-iso", "ovf-without-defaults", "empty-status"},
+		},
+		{
+			name:         "type",
+			requirements: matcher.Requirements{Type: v1alpha1.ContentLibraryItemTypeOvf},
+			expected:     []string{"ubuntu-vmx19", "windows-vmx13", "ovf-without-defaults"},
+		},
+		{
+			name:         "ready",
+			requirements: matcher.Requirements{Ready: true},
+			expected:     []string{"ubuntu-vmx19", "photon-iso", "ovf-without-defaults"},
+		},
+		{
+			name:         "the Linux family matches the guest OS and the OS hints",
+			requirements: matcher.Requirements{OSFamily: matcher.OSFamilyLinux},
+			expected:     []string{"ubuntu-vmx19", "photon-iso"},
+		},
+		{
+			name:         "the Windows family",
+			requirements: matcher.Requirements{OSFamily: matcher.OSFamilyWindows},
+			expected:     []string{"windows-vmx13"},
+		},
+		{
+			name:         "the other family matches the OS hints",
+			requirements: matcher.Requirements{OSFamily: matcher.OSFamilyOther},
+			expected:     []string{"photon-iso"},
+		},
+		{
+			name:         "the hardware version only filters OVF items",
+			requirements: matcher.Requirements{MinHWVersion: 15},
+			expected:     []string{"ubuntu-vmx19", "photon-iso", "empty-status"},
+		},
+		{
+			name:         "the hardware version is inclusive",
+			requirements: matcher.Requirements{MinHWVersion: 13},
+			expected:     []string{"ubuntu-vmx19", "windows-vmx13", "photon-iso", "empty-status"},
+		},
+		{
+			name:         "a single property",
+			requirements: matcher.Requirements{RequiredProperties: []string{"hostname"}},
+			expected:     []string{"ubuntu-vmx19", "windows-vmx13"},
+		},
+		{
+			name:         "all properties are required",
+			requirements: matcher.Requirements{RequiredProperties: []string{"hostname", "user-data"}},
+			expected:     []string{"ubuntu-vmx19"},
+		},
+		{
+			name:         "an unknown property",
+			requirements: matcher.Requirements{RequiredProperties: []string{"password"}},
+		},
+		{
+			name: "all requirements",
+			requirements: matcher.Requirements{
+				Type:               v1alpha1.ContentLibraryItemTypeOvf,
+				OSFamily:           matcher.OSFamilyLinux,
+				MinHWVersion:       19,
+				RequiredProperties: []string{"user-data"},
+				Ready:              true,
+			},
+			expected: []string{"ubuntu-vmx19"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := names(matcher.Match(testItems(), tt.requirements)); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+// otherItem is an Item of an unsupported kind.
+type otherItem struct {
+	metav1.ObjectMeta
+}
+
+func (otherItem) GetConditions() v1alpha1.Conditions { return nil }
+
+func TestMatchOtherKinds(t *testing.T) {
+	if matches := matcher.Match([]matcher.Item{&otherItem{}}, matcher.Requirements{}); len(matches) != 0 {
+		t.Errorf("expected no matches, got %d", len(matches))
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package matcher
+
+import (
+	"strings"
+)
+
+// OSFamily is a constant type that indicates a family of guest operating systems.
+type OSFamily string
+
+const (
+	// OSFamilyLinux indicates Linux guest operating systems.
+	OSFamilyLinux = OSFamily("Linux")
+
+	// OSFamilyWindows indicates Windows guest operating systems.
+	OSFamilyWindows = OSFamily("Windows")
+
+	// OSFamilyOther indicates any other guest operating system, or an unknown one.
+	OSFamilyOther = OSFamily("Other")
+)
+
+// linuxGuestIDPrefixes are the prefixes of the vSphere guest OS identifiers of Linux distributions whose
+// identifier does not contain "linux".
+var linuxGuestIDPrefixes = []string{
+	"almalinux", "asianux", "centos", "coreos", "debian", "fedora", "mandrake", "mandriva", "opensuse",
+	"oracle", "redhat", "rhel", "rocky", "sles", "suse", "turbolinux", "ubuntu", "vmwarephoton",
+}
+
+// GuestOSFamily returns the family of the guest operating system with the given vSphere guest OS identifier,
+// e.g. OSFamilyLinux for "ubuntu64Guest" and OSFamilyWindows for "windows2019srv_64Guest".
+func GuestOSFamily(guestID string) OSFamily {
+	id := strings.ToLower(guestID)
+	switch {
+	case id == "":
+		return OSFamilyOther
+	case strings.HasPrefix(id, "win"):
+		return OSFamilyWindows
+	case strings.Contains(id, "linux"):
+		return OSFamilyLinux
+	}
+	for _, prefix := range linuxGuestIDPrefixes {
+		if strings.HasPrefix(id, prefix) {
+			return OSFamilyLinux
+		}
+	}
+	return OSFamilyOther
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package metrics defines the names, labels and types of the metrics emitted for the image registry API, so
+// that the operator and its extensions expose a consistent metric surface. The package does not depend on a
+// metrics library; Register hands every definition to a function that creates and registers the collector,
+// e.g. with Prometheus:
+//
+//	collectors := map[string]prometheus.Collector{}
+//	err := metrics.Register(func(d metrics.Definition) error {
+//		var c prometheus.Collector
+//		switch d.Type {
+//		case metrics.TypeCounter:
+//			c = prometheus.NewCounterVec(prometheus.CounterOpts{
+//				Namespace: d.Namespace, Name: d.Name, Help: d.Help}, d.Labels)
+//		case metrics.TypeGauge:
+//			c = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+//				Namespace: d.Namespace, Name: d.Name, Help: d.Help}, d.Labels)
+//		case metrics.TypeHistogram:
+//			c = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+//				Namespace: d.Namespace, Name: d.Name, Help: d.Help, Buckets: d.Buckets}, d.Labels)
+//		default:
+//			return fmt.Errorf("unsupported metric type %q", d.Type)
+//		}
+//		collectors[d.FullName()] = c
+//		return registry.Register(c)
+//	})
+package metrics
+
+import (
+	"fmt"
+)
+
+// Namespace is the namespace prefixed to the name of every image registry metric.
+const Namespace = "imageregistry"
+
+// The names of the image registry metrics, without the namespace.
+const (
+	LibrarySyncDurationSeconds = "library_sync_duration_seconds"
+	ItemReadyTotal             = "item_ready_total"
+	ItemTransferBytes          = "item_transfer_bytes"
+	VCenterAPIErrorsTotal      = "vcenter_api_errors_total"
+)
+
+// The labels of the image registry metrics.
+const (
+	// LabelNamespace is the namespace of the resource, empty for cluster scoped resources.
+	LabelNamespace = "namespace"
+
+	// LabelLibrary is the name of the ContentLibrary or ClusterContentLibrary resource.
+	LabelLibrary = "library"
+
+	// LabelLibraryKind is the kind of the library resource, "ContentLibrary" or "ClusterContentLibrary".
+	LabelLibraryKind = "library_kind"
+
+	// LabelItemType is the type of the library item, e.g. "Ovf" or "Iso".
+	LabelItemType = "item_type"
+
+	// LabelResult is the result of an operation, ResultSuccess or ResultFailure.
+	LabelResult = "result"
+
+	// LabelOperation is the vCenter API operation, e.g. "library.get".
+	LabelOperation = "operation"
+
+	// LabelCode is the error code returned by the vCenter API.
+	LabelCode = "code"
+)
+
+// The values of LabelResult.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Type is the type of a metric.
+type Type string
+
+const (
+	// TypeCounter is a monotonically increasing value.
+	TypeCounter = Type("counter")
+
+	// TypeGauge is a value that can go up and down.
+	TypeGauge = Type("gauge")
+
+	// TypeHistogram is a distribution of observed values in buckets.
+	TypeHistogram = Type("histogram")
+)
+
+// Definition describes an image registry metric.
+type Definition struct {
+	// Namespace is the namespace of the metric, always Namespace.
+	Namespace string
+
+	// Name is the name of the metric without the namespace.
+	Name string
+
+	// Help is the description of the metric.
+	Help string
+
+	// Type is the type of the metric.
+	Type Type
+
+	// Labels are the names of the labels of the metric, in order.
+	Labels []string
+
+	// Buckets are the upper bounds of the buckets of a histogram. It is nil for other types.
+	Buckets []float64
+}
+
+// FullName returns the name of the metric prefixed with its namespace.
+func (d Definition) FullName() string {
+	return d.Namespace + "_" + d.Name
+}
+
+var (
+	// LibrarySyncDurationSecondsDefinition describes the duration of the syncs of a library with vCenter.
+	LibrarySyncDurationSecondsDefinition = Definition{
+		Namespace: Namespace,
+		Name:      LibrarySyncDurationSeconds,
+		Help:      "Duration in seconds of the syncs of a content library with vCenter.",
+		Type:      TypeHistogram,
+		Labels:    []string{LabelNamespace, LabelLibrary, LabelLibraryKind, LabelResult},
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+	}
+
+	// ItemReadyTotalDefinition describes the number of times a library item became ready.
+	ItemReadyTotalDefinition = Definition{
+		Namespace: Namespace,
+		Name:      ItemReadyTotal,
+		Help:      "Number of times a content library item became ready.",
+		Type:      TypeCounter,
+		Labels:    []string{LabelNamespace, LabelLibrary, LabelLibraryKind, LabelItemType},
+	}
+
+	// ItemTransferBytesDefinition describes the number of bytes transferred for library items.
+	ItemTransferBytesDefinition = Definition{
+		Namespace: Namespace,
+		Name:      ItemTransferBytes,
+		Help:      "Number of bytes transferred to vCenter for content library items.",
+		Type:      TypeCounter,
+		Labels:    []string{LabelNamespace, LabelLibrary, LabelLibraryKind, LabelItemType},
+	}
+
+	// VCenterAPIErrorsTotalDefinition describes the number of failed vCenter API calls.
+	VCenterAPIErrorsTotalDefinition = Definition{
+		Namespace: Namespace,
+		Name:      VCenterAPIErrorsTotal,
+		Help:      "Number of vCenter API calls that returned an error.",
+		Type:      TypeCounter,
+		Labels:    []string{LabelOperation, LabelCode},
+	}
+)
+
+// Definitions returns the definitions of all the image registry metrics.
+func Definitions() []Definition {
+	return []Definition{
+		LibrarySyncDurationSecondsDefinition,
+		ItemReadyTotalDefinition,
+		ItemTransferBytesDefinition,
+		VCenterAPIErrorsTotalDefinition,
+	}
+}
+
+// Register calls register with the definition of every image registry metric, in the order of Definitions,
+// and stops at the first error it returns. register is expected to create a collector of the type, labels
+// and buckets of the definition, named after its FullName, and to register it with the metrics registry of
+// the caller. Since every definition has a distinct FullName, an error usually means that the metrics were
+// already registered, e.g. by another component of the same process. The metrics registered before the error
+// are left registered.
+func Register(register func(Definition) error) error {
+	for _, d := range Definitions() {
+		if err := register(d); err != nil {
+			return fmt.Errorf("failed to register metric %s: %w", d.FullName(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package metrics_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/metrics"
+)
+
+// validName matches the metric and label names accepted by Prometheus, without the colons reserved for
+// recording rules.
+var validName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func TestDefinitions(t *testing.T) {
+	names := map[string]bool{}
+	for _, d := range metrics.Definitions() {
+		name := d.FullName()
+		if names[name] {
+			t.Errorf("%s is defined twice", name)
+		}
+		names[name] = true
+
+		if d.Namespace != metrics.Namespace {
+			t.Errorf("%s: expected the namespace %q, got %q", name, metrics.Namespace, d.Namespace)
+		}
+		if !validName.MatchString(d.Name) || !validName.MatchString(name) {
+			t.Errorf("%s: invalid name", name)
+		}
+		if d.Help == "" {
+			t.Errorf("%s: missing help", name)
+		}
+
+		labels := map[string]bool{}
+		for _, label := range d.Labels {
+			if !validName.MatchString(label) || len(label) > 1 && label[:2] == "__" {
+				t.Errorf("%s: invalid label %q", name, label)
+			}
+			if labels[label] {
+				t.Errorf("%s: the label %q is repeated", name, label)
+			}
+			labels[label] = true
+		}
+
+		switch d.Type {
+		case metrics.TypeCounter, metrics.TypeGauge:
+			if d.Buckets != nil {
+				t.Errorf("%s: only histograms have buckets", name)
+			}
+		case metrics.TypeHistogram:
+			if len(d.Buckets) == 0 {
+				t.Errorf("%s: missing buckets", name)
+			}
+			for i := 1; i < len(d.Buckets); i++ {
+				if d.Buckets[i] <= d.Buckets[i-1] {
+					t.Errorf("%s: the buckets are not increasing", name)
+				}
+			}
+		default:
+			t.Errorf("%s: unknown type %q", name, d.Type)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	var registered []string
+	err := metrics.Register(func(d metrics.Definition) error {
+		registered = append(registered, d.FullName())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(registered) != len(metrics.Definitions()) {
+		t.Errorf("expected every definition to be registered, got %v", registered)
+	}
+
+	errDuplicate := errors.New("duplicate metrics collector registration attempted")
+	calls := 0
+	err = metrics.Register(func(metrics.Definition) error {
+		calls++
+		return errDuplicate
+	})
+	if !errors.Is(err, errDuplicate) {
+		t.Errorf("expected the error of register, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected Register to stop at the first error, got %d calls", calls)
+	}
+}
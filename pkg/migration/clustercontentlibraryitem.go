@@ -0,0 +1,106 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package migration
+
+import (
+	"fmt"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/conditions"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/rbac"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LegacyItemNotReadyReason documents that a migrated item was not ready before it was migrated. The condition
+// is replaced by its controller once the migrated item is reconciled.
+const LegacyItemNotReadyReason = "LegacyItemNotReady"
+
+// ClusterContentLibraryItemsResource is the resource that is listed to find the legacy ClusterContentLibraryItems.
+var ClusterContentLibraryItemsResource = v1alpha1.SchemeGroupVersion.WithResource(rbac.ClusterContentLibraryItems)
+
+// IsLegacyItem returns true if the ClusterContentLibraryItem does not follow the current conventions of the
+// API and must be converted with ConvertLegacyItem, i.e. if it needs to be renamed or backfilled.
+func IsLegacyItem(item *v1alpha1.ClusterContentLibraryItem) bool {
+	return NeedsRename(item) || NeedsBackfill(item)
+}
+
+// NeedsRename returns true if the ClusterContentLibraryItem is not named after its vCenter UUID. Since names
+// are immutable, such an item must be re-created under its new name.
+func NeedsRename(item *v1alpha1.ClusterContentLibraryItem) bool {
+	return !v1alpha1.NameMatchesUUID(item.Name, string(item.Spec.UUID), v1alpha1.ClusterContentLibraryItemNamePrefix)
+}
+
+// NeedsBackfill returns true if the ClusterContentLibraryItem lacks the UUID annotation, the cache status or
+// the Ready condition. Such an item is updated in place if it does not need to be renamed.
+func NeedsBackfill(item *v1alpha1.ClusterContentLibraryItem) bool {
+	if _, ok := v1alpha1.UUIDFromAnnotation(item); !ok {
+		return true
+	}
+	return item.Status.CacheStatus == "" || !conditions.Has(item, v1alpha1.ConditionReady)
+}
+
+// FindLegacyItems returns the legacy items among the given ClusterContentLibraryItems, in their original order.
+func FindLegacyItems(items []v1alpha1.ClusterContentLibraryItem) []*v1alpha1.ClusterContentLibraryItem {
+	var legacy []*v1alpha1.ClusterContentLibraryItem
+	for i := range items {
+		if IsLegacyItem(&items[i]) {
+			legacy = append(legacy, &items[i])
+		}
+	}
+	return legacy
+}
+
+// ConvertLegacyItem returns the ClusterContentLibraryItem that replaces the legacy item, with the UUID
+// annotation, the well-known labels, the cache status and the Ready condition derived from the legacy item.
+// The legacy item is not modified. How the returned item is applied depends on NeedsRename:
+//
+//   - If the legacy item needs to be renamed, the returned item is named after the vCenter UUID of the item
+//     and the metadata set by the API server is cleared. Create it, copy its status onto the created item and
+//     update the status subresource of the created item, since the API server drops the status on creation,
+//     and only then delete the legacy item.
+//   - Otherwise the returned item keeps the name and resource version of the legacy item. Update it, then
+//     update its status subresource. It must not be created and the legacy item must not be deleted, since
+//     they are the same resource.
+func ConvertLegacyItem(legacy *v1alpha1.ClusterContentLibraryItem) (*v1alpha1.ClusterContentLibraryItem, error) {
+	uuid, err := v1alpha1.ParseItemID(string(legacy.Spec.UUID))
+	if err != nil {
+		return nil, fmt.Errorf("ClusterContentLibraryItem %s: %w", legacy.Name, err)
+	}
+	if legacy.Status.ClusterContentLibraryRef == "" {
+		return nil, fmt.Errorf("ClusterContentLibraryItem %s does not refer to a ClusterContentLibrary", legacy.Name)
+	}
+
+	item := legacy.DeepCopy()
+	if NeedsRename(legacy) {
+		item.ObjectMeta = metav1.ObjectMeta{
+			Name:            v1alpha1.NameFromUUID(uuid.String(), v1alpha1.ClusterContentLibraryItemNamePrefix),
+			Labels:          item.Labels,
+			Annotations:     item.Annotations,
+			OwnerReferences: item.OwnerReferences,
+		}
+		// The UUID of an existing item is immutable, so it is only normalized for a new item.
+		item.Spec.UUID = uuid.Normalize()
+	}
+	v1alpha1.SetUUIDAnnotation(item, uuid.Normalize().String())
+
+	if item.Status.Phase == "" {
+		item.Status.Phase = v1alpha1.ContentLibraryItemPhaseAvailable
+	}
+	if item.Status.CacheStatus == "" {
+		item.Status.CacheStatus = v1alpha1.CacheStatusNotCached
+		if item.Status.Cached {
+			item.Status.CacheStatus = v1alpha1.CacheStatusCached
+		}
+	}
+	if !conditions.Has(item, v1alpha1.ConditionReady) {
+		if item.Status.Ready {
+			conditions.MarkTrue(item, v1alpha1.ConditionReady)
+		} else {
+			conditions.MarkFalse(item, v1alpha1.ConditionReady, LegacyItemNotReadyReason, v1alpha1.ConditionSeverityInfo,
+				"the legacy item was not ready when it was migrated")
+		}
+	}
+	v1alpha1.SyncLabels(item)
+
+	return item, nil
+}
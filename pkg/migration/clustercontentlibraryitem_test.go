@@ -0,0 +1,71 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package migration_test
+
+import (
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/conditions"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/migration"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const itemUUID = "8C2D4E6F-1A3B-4C5D-8E9F-0A1B2C3D4E5F"
+
+func legacyItem(name string) *v1alpha1.ClusterContentLibraryItem {
+	return &v1alpha1.ClusterContentLibraryItem{
+		ObjectMeta: metav1.ObjectMeta{Name: name, ResourceVersion: "42", UID: "legacy"},
+		Spec:       v1alpha1.ClusterContentLibraryItemSpec{UUID: itemUUID},
+		Status: v1alpha1.ClusterContentLibraryItemStatus{
+			ClusterContentLibraryRef: "cluster-library",
+			Cached:                   true,
+			Ready:                    true,
+		},
+	}
+}
+
+func TestConvertLegacyItemRename(t *testing.T) {
+	legacy := legacyItem("ubuntu")
+	if !migration.NeedsRename(legacy) || !migration.NeedsBackfill(legacy) {
+		t.Fatal("expected the item to need a rename and a backfill")
+	}
+
+	item, err := migration.ConvertLegacyItem(legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Name == legacy.Name || item.ResourceVersion != "" || item.UID != "" {
+		t.Errorf("expected a new item without server metadata, got %+v", item.ObjectMeta)
+	}
+	if item.Spec.UUID != v1alpha1.ItemID(v1alpha1.NormalizeUUID(itemUUID)) {
+		t.Errorf("expected the UUID of the new item to be normalized, got %s", item.Spec.UUID)
+	}
+	if migration.IsLegacyItem(item) {
+		t.Error("expected the converted item not to be a legacy item")
+	}
+}
+
+func TestConvertLegacyItemBackfill(t *testing.T) {
+	legacy := legacyItem(v1alpha1.NameFromUUID(itemUUID, v1alpha1.ClusterContentLibraryItemNamePrefix))
+	if migration.NeedsRename(legacy) || !migration.NeedsBackfill(legacy) {
+		t.Fatal("expected the item to need a backfill only")
+	}
+
+	item, err := migration.ConvertLegacyItem(legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Name != legacy.Name || item.ResourceVersion != legacy.ResourceVersion || item.UID != legacy.UID {
+		t.Errorf("expected the item to be updated in place, got %+v", item.ObjectMeta)
+	}
+	if item.Spec.UUID != legacy.Spec.UUID {
+		t.Errorf("expected the immutable UUID to be kept, got %s", item.Spec.UUID)
+	}
+	if item.Status.CacheStatus != v1alpha1.CacheStatusCached || !conditions.IsTrue(item, v1alpha1.ConditionReady) {
+		t.Errorf("expected the status to be backfilled, got %+v", item.Status)
+	}
+	if migration.IsLegacyItem(item) {
+		t.Error("expected the converted item not to be a legacy item")
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package migration converts the ClusterContentLibraryItem resources created by earlier versions of the
+// content library operator to the current conventions of the API, e.g. names derived from the vCenter UUID,
+// well-known labels and the Ready condition. It is used by the one-shot migration Job shipped with the
+// operator, which lists the ClusterContentLibraryItems and converts every legacy item. A legacy item that is
+// not named after its vCenter UUID is re-created under its new name and then deleted; any other legacy item
+// is updated in place, its status through the status subresource.
+package migration
@@ -0,0 +1,7 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package oci translates between library items and OCI-style references of the form
+// "registry/repository:tag@digest", so that library items can be handled by the signing, SBOM and policy
+// tools built around OCI artifacts. The content of a library item maps to the repository
+// "<library-uuid>/<item-uuid>", and its content version to the tag "v<content-version>".
+package oci
@@ -0,0 +1,129 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package oci
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+)
+
+const (
+	// tagPrefix prefixes the content version of a library item in its tag, since tags that only consist of
+	// digits are easily mistaken for ports by tools.
+	tagPrefix = "v"
+)
+
+var (
+	tagRegexp    = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+	digestRegexp = regexp.MustCompile(`^[a-z0-9]+(?:[.+_-][a-z0-9]+)*:[a-zA-Z0-9=_-]+$`)
+)
+
+// Reference is an OCI-style reference of the form "registry/repository:tag@digest". The tag and the digest
+// are optional.
+type Reference struct {
+	// Registry is the host, and optional port, of the registry, e.g. "registry.example.com".
+	Registry string
+
+	// Repository is the path of the repository within the registry.
+	Repository string
+
+	// Tag is the tag of the artifact, if any.
+	Tag string
+
+	// Digest is the digest of the artifact, e.g. "sha256:<hex>", if any.
+	Digest string
+}
+
+// String returns the reference in the "registry/repository:tag@digest" form.
+func (r Reference) String() string {
+	var b strings.Builder
+	b.WriteString(r.Registry)
+	b.WriteString("/")
+	b.WriteString(r.Repository)
+	if r.Tag != "" {
+		b.WriteString(":")
+		b.WriteString(r.Tag)
+	}
+	if r.Digest != "" {
+		b.WriteString("@")
+		b.WriteString(r.Digest)
+	}
+	return b.String()
+}
+
+// ParseReference parses a reference of the form "registry/repository[:tag][@digest]".
+func ParseReference(s string) (Reference, error) {
+	var ref Reference
+
+	rest := s
+	if i := strings.Index(rest, "@"); i >= 0 {
+		rest, ref.Digest = rest[:i], rest[i+1:]
+		if !digestRegexp.MatchString(ref.Digest) {
+			return Reference{}, fmt.Errorf("invalid digest in OCI reference %q", s)
+		}
+	}
+
+	i := strings.Index(rest, "/")
+	if i <= 0 || i == len(rest)-1 {
+		return Reference{}, fmt.Errorf("OCI reference %q must have a registry and a repository", s)
+	}
+	ref.Registry, rest = rest[:i], rest[i+1:]
+
+	if j := strings.LastIndex(rest, ":"); j >= 0 {
+		rest, ref.Tag = rest[:j], rest[j+1:]
+		if !tagRegexp.MatchString(ref.Tag) {
+			return Reference{}, fmt.Errorf("invalid tag in OCI reference %q", s)
+		}
+	}
+	if rest == "" {
+		return Reference{}, fmt.Errorf("OCI reference %q must have a repository", s)
+	}
+	ref.Repository = rest
+
+	return ref, nil
+}
+
+// ForItem returns the reference of the given content version of a library item in the registry. The digest
+// is optional.
+func ForItem(registry string, library v1alpha1.LibraryID, item v1alpha1.ItemID, contentVersion, digest string) Reference {
+	ref := Reference{
+		Registry:   registry,
+		Repository: library.Normalize().String() + "/" + item.Normalize().String(),
+		Digest:     digest,
+	}
+	if contentVersion != "" {
+		ref.Tag = tagPrefix + contentVersion
+	}
+	return ref
+}
+
+// ItemFromReference returns the library, the library item and the content version a reference returned by
+// ForItem refers to. The content version is empty if the reference has no tag.
+func ItemFromReference(ref Reference) (v1alpha1.LibraryID, v1alpha1.ItemID, string, error) {
+	parts := strings.Split(ref.Repository, "/")
+	if len(parts) != 2 {
+		return "", "", "", fmt.Errorf("repository %q is not of the form <library-uuid>/<item-uuid>", ref.Repository)
+	}
+
+	library, err := v1alpha1.ParseLibraryID(parts[0])
+	if err != nil {
+		return "", "", "", err
+	}
+	item, err := v1alpha1.ParseItemID(parts[1])
+	if err != nil {
+		return "", "", "", err
+	}
+
+	var contentVersion string
+	if ref.Tag != "" {
+		if !strings.HasPrefix(ref.Tag, tagPrefix) {
+			return "", "", "", fmt.Errorf("tag %q is not of the form %s<content-version>", ref.Tag, tagPrefix)
+		}
+		contentVersion = strings.TrimPrefix(ref.Tag, tagPrefix)
+	}
+
+	return library, item, contentVersion, nil
+}
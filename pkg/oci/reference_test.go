@@ -0,0 +1,131 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package oci_test
+
+import (
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/oci"
+)
+
+const digest = "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		name      string
+		reference string
+		expected  oci.Reference
+		invalid   bool
+	}{
+		{
+			name:      "a registry with a port, a tag and a digest",
+			reference: "host:5000/lib/item:v1@" + digest,
+			expected:  oci.Reference{Registry: "host:5000", Repository: "lib/item", Tag: "v1", Digest: digest},
+		},
+		{
+			name:      "a registry with a port and no tag",
+			reference: "host:5000/lib/item",
+			expected:  oci.Reference{Registry: "host:5000", Repository: "lib/item"},
+		},
+		{
+			name:      "a digest without a tag",
+			reference: "registry.example.com/item@" + digest,
+			expected:  oci.Reference{Registry: "registry.example.com", Repository: "item", Digest: digest},
+		},
+		{name: "no registry", reference: "item:v1", invalid: true},
+		{name: "a missing repository", reference: "registry.example.com/", invalid: true},
+		{name: "a missing repository with a tag", reference: "registry.example.com/:v1", invalid: true},
+		{name: "an empty registry", reference: "/lib/item", invalid: true},
+		{name: "an empty tag", reference: "registry.example.com/item:", invalid: true},
+		{name: "an invalid tag", reference: "registry.example.com/item:-v1", invalid: true},
+		{name: "a colon within the repository", reference: "registry.example.com/lib:v1/item", invalid: true},
+		{name: "an invalid digest", reference: "registry.example.com/item@sha256", invalid: true},
+		{name: "an empty digest", reference: "registry.example.com/item:v1@", invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := oci.ParseReference(tt.reference)
+			if tt.invalid {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", ref)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ref != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, ref)
+			}
+			if s := ref.String(); s != tt.reference {
+				t.Errorf("expected the reference to print as %q, got %q", tt.reference, s)
+			}
+		})
+	}
+}
+
+func TestItemRoundTrip(t *testing.T) {
+	const (
+		library = v1alpha1.LibraryID("dc1a7e76-4a30-4d5e-9a8b-3c1f4e3b2a10")
+		item    = v1alpha1.ItemID("3f9b9d2e-5c1a-4d8e-9f2b-1a2b3c4d5e6f")
+	)
+
+	tests := []struct {
+		name           string
+		registry       string
+		library        v1alpha1.LibraryID
+		contentVersion string
+		digest         string
+	}{
+		{name: "a content version and a digest", registry: "host:5000", library: library, contentVersion: "3", digest: digest},
+		{name: "no content version", registry: "registry.example.com", library: library},
+		{name: "an upper case UUID", registry: "registry.example.com", library: "DC1A7E76-4A30-4D5E-9A8B-3C1F4E3B2A10", contentVersion: "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := oci.ForItem(tt.registry, tt.library, item, tt.contentVersion, tt.digest).String()
+			ref, err := oci.ParseReference(s)
+			if err != nil {
+				t.Fatalf("failed to parse %q: %v", s, err)
+			}
+			if ref.Registry != tt.registry || ref.Digest != tt.digest {
+				t.Errorf("expected the registry %q and the digest %q, got %+v", tt.registry, tt.digest, ref)
+			}
+
+			gotLibrary, gotItem, contentVersion, err := oci.ItemFromReference(ref)
+			if err != nil {
+				t.Fatalf("failed to get the item of %q: %v", s, err)
+			}
+			if gotLibrary != library || gotItem != item || contentVersion != tt.contentVersion {
+				t.Errorf("expected %s, %s and %q, got %s, %s and %q", library, item, tt.contentVersion,
+					gotLibrary, gotItem, contentVersion)
+			}
+		})
+	}
+}
+
+func TestItemFromReferenceErrors(t *testing.T) {
+	const uuid = "dc1a7e76-4a30-4d5e-9a8b-3c1f4e3b2a10"
+
+	tests := []struct {
+		name string
+		ref  oci.Reference
+	}{
+		{name: "a single path segment", ref: oci.Reference{Registry: "r", Repository: uuid}},
+		{name: "too many path segments", ref: oci.Reference{Registry: "r", Repository: uuid + "/" + uuid + "/" + uuid}},
+		{name: "an invalid library UUID", ref: oci.Reference{Registry: "r", Repository: "library/" + uuid}},
+		{name: "an invalid item UUID", ref: oci.Reference{Registry: "r", Repository: uuid + "/item"}},
+		{name: "a tag without the prefix", ref: oci.Reference{Registry: "r", Repository: uuid + "/" + uuid, Tag: "1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, _, err := oci.ItemFromReference(tt.ref); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
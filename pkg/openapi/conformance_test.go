@@ -0,0 +1,538 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package openapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/openapi"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/openapi/openapitest"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/yaml"
+)
+
+// fuzzIterations is the number of randomly populated objects of every kind that are round-tripped.
+const fuzzIterations = 50
+
+// fuzzSeed overrides the seed of the random objects if it is not 0.
+var fuzzSeed = flag.Int64("fuzz-seed", 0, "the seed of the randomly populated objects, or 0 for a random seed")
+
+// newFuzzSeed returns the seed of the random objects and logs it, so that a failure can be reproduced.
+func newFuzzSeed(t *testing.T) int64 {
+	seed := *fuzzSeed
+	if seed == 0 {
+		seed = rand.Int63()
+	}
+	t.Logf("fuzzing with the seed %d, set -fuzz-seed=%d to reproduce", seed, seed)
+	return seed
+}
+
+// kinds returns the Go types of the kinds of the image registry API, keyed by their group, version and kind.
+func kinds() map[schema.GroupVersionKind]reflect.Type {
+	kinds := map[schema.GroupVersionKind]reflect.Type{}
+	for gvk, typ := range openapitest.Scheme().AllKnownTypes() {
+		if gvk.GroupVersion() == v1alpha1.SchemeGroupVersion && typ.PkgPath() == reflect.TypeOf(v1alpha1.ContentLibrary{}).PkgPath() &&
+			!strings.HasSuffix(gvk.Kind, "List") {
+			kinds[gvk] = typ
+		}
+	}
+	return kinds
+}
+
+func schemas(t *testing.T) map[schema.GroupVersionKind]map[string]interface{} {
+	t.Helper()
+	all, err := openapi.Schemas()
+	if err != nil {
+		t.Fatalf("failed to load the schemas: %v", err)
+	}
+	schemas := map[schema.GroupVersionKind]map[string]interface{}{}
+	for _, s := range all {
+		schemas[s.GroupVersionKind] = s.OpenAPIV3Schema
+	}
+	return schemas
+}
+
+func TestSchemasCoverEveryKind(t *testing.T) {
+	schemas := schemas(t)
+	kinds := kinds()
+	for gvk := range kinds {
+		if _, ok := schemas[gvk]; !ok {
+			t.Errorf("%s has no schema, run 'make generate-openapi'", gvk)
+		}
+	}
+	for gvk := range schemas {
+		if _, ok := kinds[gvk]; !ok {
+			t.Errorf("the schema of %s has no Go type registered with the scheme", gvk)
+		}
+	}
+}
+
+// TestSchemasMatchGoTypes checks that the markers of the Go types and the generated schemas agree: every field
+// is declared by the schema, required fields are not omitted when empty, and enums only list constants
+// declared for their type.
+func TestSchemasMatchGoTypes(t *testing.T) {
+	schemas := schemas(t)
+	checker := &typeChecker{t: t, constants: stringConstants(t)}
+	for gvk, typ := range kinds() {
+		if s, ok := schemas[gvk]; ok {
+			checker.check(gvk.Kind, typ, s)
+		}
+	}
+}
+
+// TestImmutableFields checks that the fields that cannot be changed once set carry their immutability rule.
+func TestImmutableFields(t *testing.T) {
+	schemas := schemas(t)
+	immutable := map[string][]string{
+		"ClusterContentLibrary":               {"spec.uuid", "spec.vCenterRef"},
+		"ClusterContentLibraryItem":           {"spec.uuid"},
+		"ContentLibrary":                      {"spec.uuid", "spec.create", "spec.vCenterRef", "spec.storagePolicyID", "spec.storageClassName"},
+		"ContentLibraryItem":                  {"spec.uuid"},
+		"ContentLibraryItemFileUpload":        {"spec.contentLibraryItemRef"},
+		"ContentLibraryItemValidationRequest": {"spec.itemRef"},
+		"ContentLibraryItemVersion":           {"spec"},
+		"ContentLibraryItemVolumeRequest":     {"spec"},
+		"ContentLibrarySyncRequest":           {"spec"},
+	}
+	for kind, paths := range immutable {
+		gvk := v1alpha1.SchemeGroupVersion.WithKind(kind)
+		for _, path := range paths {
+			s := lookup(schemas[gvk], path)
+			if s == nil {
+				t.Errorf("%s: %s is not declared by the schema", kind, path)
+				continue
+			}
+			if !hasRule(s, "self == oldSelf") {
+				t.Errorf("%s: %s has no immutability rule", kind, path)
+			}
+		}
+	}
+
+	// The rule of an optional field only runs when the field is set before and after an update, so the rules
+	// of their parents keep them from being set or unset. The presence of spec.uuid of a ContentLibrary
+	// follows from the presence of spec.create.
+	optional := map[string][]string{
+		"ClusterContentLibrary": {"spec.vCenterRef"},
+		"ContentLibrary":        {"spec.create", "spec.vCenterRef", "spec.storagePolicyID", "spec.storageClassName"},
+	}
+	for kind, paths := range optional {
+		gvk := v1alpha1.SchemeGroupVersion.WithKind(kind)
+		for _, path := range paths {
+			i := strings.LastIndex(path, ".")
+			parent, name := path[:i], path[i+1:]
+			if !hasRule(lookup(schemas[gvk], parent), fmt.Sprintf("has(self.%s) == has(oldSelf.%s)", name, name)) {
+				t.Errorf("%s: %s can be set or unset after creation", kind, path)
+			}
+		}
+	}
+}
+
+// TestRootRulesIgnoreStatus checks that no validation rule of a whole object reads its status, since such a
+// rule would reject the status updates of the operator depending on the spec.
+func TestRootRulesIgnoreStatus(t *testing.T) {
+	for gvk, s := range schemas(t) {
+		validations, _ := s["x-kubernetes-validations"].([]interface{})
+		for _, v := range validations {
+			if rule, _ := v.(map[string]interface{})["rule"].(string); strings.Contains(rule, "self.status") {
+				t.Errorf("%s: the rule %q reads the status", gvk.Kind, rule)
+			}
+		}
+	}
+}
+
+// TestUUIDFields checks that every UUID field is checked against v1alpha1.UUIDPattern and bounded in length,
+// and that the lists holding them are bounded, which keeps the estimated cost of their rules low.
+func TestUUIDFields(t *testing.T) {
+	uuidRule := fmt.Sprintf("self.matches('%s')", v1alpha1.UUIDPattern)
+	for gvk, s := range schemas(t) {
+		walk(gvk.Kind, s, func(path string, s map[string]interface{}) {
+			if strings.HasSuffix(strings.ToLower(path), "uuid") && s["type"] == "string" {
+				if !hasRule(s, uuidRule) {
+					t.Errorf("%s is not checked against UUIDPattern", path)
+				}
+				if s["maxLength"] != float64(36) {
+					t.Errorf("%s has no maxLength of 36", path)
+				}
+			}
+			if items, ok := s["items"].(map[string]interface{}); ok && s["maxItems"] == nil && hasNestedRule(items) {
+				t.Errorf("%s has items with validation rules but no maxItems", path)
+			}
+		})
+	}
+}
+
+// TestTTLDefaults checks that the API server defaults every ttlSecondsAfterFinished field to
+// v1alpha1.DefaultTTLSecondsAfterFinished, so that requests created without the defaulting webhook are
+// garbage collected too.
+func TestTTLDefaults(t *testing.T) {
+	found := 0
+	for gvk, s := range schemas(t) {
+		walk(gvk.Kind, s, func(path string, s map[string]interface{}) {
+			if !strings.HasSuffix(path, ".ttlSecondsAfterFinished") {
+				return
+			}
+			found++
+			if s["default"] != float64(v1alpha1.DefaultTTLSecondsAfterFinished) {
+				t.Errorf("%s defaults to %v instead of %d", path, s["default"], v1alpha1.DefaultTTLSecondsAfterFinished)
+			}
+		})
+	}
+	if found == 0 {
+		t.Error("no ttlSecondsAfterFinished field was found")
+	}
+}
+
+// walk calls fn for s and every schema nested in its properties, items and additional properties.
+func walk(path string, s map[string]interface{}, fn func(string, map[string]interface{})) {
+	fn(path, s)
+	properties, _ := s["properties"].(map[string]interface{})
+	for name, property := range properties {
+		walk(path+"."+name, property.(map[string]interface{}), fn)
+	}
+	if items, ok := s["items"].(map[string]interface{}); ok {
+		walk(path+"[]", items, fn)
+	}
+	if additional, ok := s["additionalProperties"].(map[string]interface{}); ok {
+		walk(path+"{}", additional, fn)
+	}
+}
+
+func hasNestedRule(s map[string]interface{}) bool {
+	found := false
+	walk("", s, func(_ string, s map[string]interface{}) {
+		if _, ok := s["x-kubernetes-validations"]; ok {
+			found = true
+		}
+	})
+	return found
+}
+
+// TestPrinterColumnsResolve checks that the JSONPath of every printer column refers to a field declared by
+// the schema, which fails when a column points at a field that moved or never existed.
+func TestPrinterColumnsResolve(t *testing.T) {
+	files, err := filepath.Glob("crds/*.yaml")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to find the CRDs: %v", err)
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		var crd struct {
+			Spec struct {
+				Versions []struct {
+					AdditionalPrinterColumns []struct {
+						Name     string `json:"name"`
+						JSONPath string `json:"jsonPath"`
+					} `json:"additionalPrinterColumns"`
+					Schema struct {
+						OpenAPIV3Schema map[string]interface{} `json:"openAPIV3Schema"`
+					} `json:"schema"`
+				} `json:"versions"`
+			} `json:"spec"`
+		}
+		if err := yaml.Unmarshal(data, &crd); err != nil {
+			t.Fatalf("failed to parse %s: %v", file, err)
+		}
+
+		for _, version := range crd.Spec.Versions {
+			for _, column := range version.AdditionalPrinterColumns {
+				path := strings.TrimPrefix(jsonPathFilter.ReplaceAllString(column.JSONPath, "[]"), ".")
+				if strings.HasPrefix(path, "metadata.") {
+					// The schema does not declare the fields of the object metadata.
+					continue
+				}
+				if lookup(version.Schema.OpenAPIV3Schema, path) == nil {
+					t.Errorf("%s: the %s column refers to %s, which is not declared by the schema",
+						filepath.Base(file), column.Name, column.JSONPath)
+				}
+			}
+		}
+	}
+}
+
+// jsonPathFilter matches the filter expressions of a JSONPath, e.g. "[?(@.type=='Ready')]".
+var jsonPathFilter = regexp.MustCompile(`\[[^\]]*\]`)
+
+// TestRoundTripFuzzedObjects checks that randomly populated objects of every kind survive being pruned by
+// the schema of their kind, which fails when a field of a Go type is missing from the schema.
+func TestRoundTripFuzzedObjects(t *testing.T) {
+	scheme := openapitest.Scheme()
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(newFuzzSeed(t)), runtimeserializer.NewCodecFactory(scheme))
+	for gvk := range kinds() {
+		for i := 0; i < fuzzIterations; i++ {
+			obj, err := scheme.New(gvk)
+			if err != nil {
+				t.Fatalf("failed to create a %s: %v", gvk.Kind, err)
+			}
+			f.Fuzz(obj)
+			obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+			into, _ := scheme.New(gvk)
+			pruned, err := openapitest.RoundTrip(obj, into)
+			if err != nil {
+				t.Fatalf("failed to round-trip a %s: %v", gvk.Kind, err)
+			}
+			if len(pruned) > 0 {
+				t.Errorf("%s: the schema prunes %s", gvk.Kind, strings.Join(pruned, ", "))
+				break
+			}
+			if !sameJSON(t, obj, into) {
+				t.Errorf("%s: the object changed in the round-trip: %s", gvk.Kind, diff.ObjectReflectDiff(obj, into))
+				break
+			}
+		}
+	}
+}
+
+// sameJSON returns whether a and b have the same JSON representation, which tells whether their
+// differences, such as nil and empty slices, would be lost in transit anyway.
+func sameJSON(t *testing.T, a, b interface{}) bool {
+	t.Helper()
+	aData, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("failed to encode %T: %v", a, err)
+	}
+	bData, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("failed to encode %T: %v", b, err)
+	}
+	return bytes.Equal(aData, bData)
+}
+
+type typeChecker struct {
+	t         *testing.T
+	constants map[string][]string
+}
+
+var (
+	jsonMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	objectMeta    = reflect.TypeOf(metav1.ObjectMeta{})
+	apiPkgPath    = reflect.TypeOf(v1alpha1.ContentLibrary{}).PkgPath()
+)
+
+func (c *typeChecker) check(path string, typ reflect.Type, s map[string]interface{}) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == objectMeta || typ.Implements(jsonMarshaler) || reflect.PtrTo(typ).Implements(jsonMarshaler) {
+		return
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		c.expectType(path, s, "object")
+		c.checkStruct(path, typ, s)
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			c.expectType(path, s, "string")
+			return
+		}
+		c.expectType(path, s, "array")
+		if items, ok := s["items"].(map[string]interface{}); ok {
+			c.check(path+"[]", typ.Elem(), items)
+		}
+	case reflect.Map:
+		c.expectType(path, s, "object")
+		if additional, ok := s["additionalProperties"].(map[string]interface{}); ok {
+			c.check(path+"{}", typ.Elem(), additional)
+		}
+	case reflect.String:
+		c.expectType(path, s, "string")
+		c.checkEnum(path, typ, s)
+	case reflect.Bool:
+		c.expectType(path, s, "boolean")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		c.expectType(path, s, "integer")
+	case reflect.Float32, reflect.Float64:
+		c.expectType(path, s, "number")
+	}
+}
+
+func (c *typeChecker) checkStruct(path string, typ reflect.Type, s map[string]interface{}) {
+	properties, _ := s["properties"].(map[string]interface{})
+	required := map[string]bool{}
+	if names, ok := s["required"].([]interface{}); ok {
+		for _, name := range names {
+			required[name.(string)] = true
+		}
+	}
+
+	declared := map[string]bool{}
+	c.checkFields(path, typ, properties, required, declared)
+	for name := range properties {
+		if !declared[name] {
+			c.t.Errorf("%s.%s is declared by the schema but not by %s", path, name, typ)
+		}
+	}
+}
+
+func (c *typeChecker) checkFields(path string, typ reflect.Type, properties map[string]interface{},
+	required, declared map[string]bool) {
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name, options := parseTag(field.Tag.Get("json"))
+		if name == "-" || field.PkgPath != "" {
+			continue
+		}
+		if field.Anonymous && (name == "" || options["inline"]) {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			c.checkFields(path, embedded, properties, required, declared)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		declared[name] = true
+		property, ok := properties[name].(map[string]interface{})
+		if !ok {
+			c.t.Errorf("%s.%s is declared by %s but not by the schema", path, name, typ)
+			continue
+		}
+		if required[name] && options["omitempty"] && field.Type.Kind() != reflect.Struct {
+			c.t.Errorf("%s.%s is required but omitted when empty", path, name)
+		}
+		c.check(path+"."+name, field.Type, property)
+	}
+}
+
+// checkEnum checks that the enum of a field whose type has constants only lists those constants. An enum
+// may list fewer, e.g. to only accept some item types in a spec.
+func (c *typeChecker) checkEnum(path string, typ reflect.Type, s map[string]interface{}) {
+	enum, ok := s["enum"].([]interface{})
+	if !ok || typ.PkgPath() != apiPkgPath {
+		return
+	}
+	constants := c.constants[typ.Name()]
+	if len(constants) == 0 {
+		return
+	}
+
+	for _, v := range enum {
+		i := sort.SearchStrings(constants, v.(string))
+		if i == len(constants) || constants[i] != v {
+			c.t.Errorf("%s: the enum value %q is not a constant of %s", path, v, typ.Name())
+		}
+	}
+}
+
+func (c *typeChecker) expectType(path string, s map[string]interface{}, expected string) {
+	if intOrString, _ := s["x-kubernetes-int-or-string"].(bool); intOrString {
+		return
+	}
+	if actual, _ := s["type"].(string); actual != expected {
+		c.t.Errorf("%s has the schema type %q, expected %q", path, actual, expected)
+	}
+}
+
+func parseTag(tag string) (string, map[string]bool) {
+	parts := strings.Split(tag, ",")
+	options := map[string]bool{}
+	for _, option := range parts[1:] {
+		options[option] = true
+	}
+	return parts[0], options
+}
+
+// stringConstants returns the string constants declared by the API package, sorted and keyed by the name of
+// their type.
+func stringConstants(t *testing.T) map[string][]string {
+	t.Helper()
+	pkgs, err := parser.ParseDir(token.NewFileSet(), "../../api/v1alpha1", nil, 0)
+	if err != nil {
+		t.Fatalf("failed to parse the API package: %v", err)
+	}
+
+	constants := map[string][]string{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.CONST {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					if typ, s, ok := stringConstant(spec.(*ast.ValueSpec)); ok {
+						constants[typ] = append(constants[typ], s)
+					}
+				}
+			}
+		}
+	}
+	for _, values := range constants {
+		sort.Strings(values)
+	}
+	return constants
+}
+
+// stringConstant returns the type and value of a typed string constant, declared either as
+// `Name Type = "value"` or as `Name = Type("value")`.
+func stringConstant(spec *ast.ValueSpec) (string, string, bool) {
+	if len(spec.Values) != 1 {
+		return "", "", false
+	}
+	typ, _ := spec.Type.(*ast.Ident)
+	value := spec.Values[0]
+	if call, ok := value.(*ast.CallExpr); ok && typ == nil && len(call.Args) == 1 {
+		typ, _ = call.Fun.(*ast.Ident)
+		value = call.Args[0]
+	}
+	lit, ok := value.(*ast.BasicLit)
+	if typ == nil || !ok || lit.Kind != token.STRING {
+		return "", "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return typ.Name, s, err == nil
+}
+
+// lookup returns the schema of the dot separated path of properties in s. A "[]" suffix descends into the
+// items of an array.
+func lookup(s map[string]interface{}, path string) map[string]interface{} {
+	for _, name := range strings.Split(path, ".") {
+		properties, _ := s["properties"].(map[string]interface{})
+		s, _ = properties[strings.TrimSuffix(name, "[]")].(map[string]interface{})
+		if strings.HasSuffix(name, "[]") {
+			s, _ = s["items"].(map[string]interface{})
+		}
+	}
+	return s
+}
+
+func hasRule(s map[string]interface{}, rule string) bool {
+	validations, _ := s["x-kubernetes-validations"].([]interface{})
+	for _, v := range validations {
+		if v.(map[string]interface{})["rule"] == rule {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package openapi exports the OpenAPI v3 schemas of the kinds of the image registry API, so that external
+// tooling such as user interfaces and policy engines can generate validation bindings against exactly the
+// version of the API they import. The schemas are read from the CRDs embedded in the package, which are
+// regenerated from the API types by "make generate-openapi".
+package openapi
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+//go:embed crds/*.yaml
+var crds embed.FS
+
+// Schema is the OpenAPI v3 schema of a version of a kind of the image registry API.
+type Schema struct {
+	// GroupVersionKind identifies the kind and version the schema applies to.
+	GroupVersionKind schema.GroupVersionKind
+
+	// Namespaced indicates whether the kind is namespaced or cluster scoped.
+	Namespaced bool
+
+	// OpenAPIV3Schema is the structural schema of the kind, including the validation rules of the CRD.
+	OpenAPIV3Schema map[string]interface{}
+}
+
+// customResourceDefinition is the part of a CRD the schemas are read from.
+type customResourceDefinition struct {
+	Spec struct {
+		Group string `json:"group"`
+		Names struct {
+			Kind string `json:"kind"`
+		} `json:"names"`
+		Scope    string `json:"scope"`
+		Versions []struct {
+			Name   string `json:"name"`
+			Schema struct {
+				OpenAPIV3Schema map[string]interface{} `json:"openAPIV3Schema"`
+			} `json:"schema"`
+		} `json:"versions"`
+	} `json:"spec"`
+}
+
+// Schemas returns the OpenAPI v3 schemas of every version of every kind of the image registry API, sorted
+// by kind and version.
+func Schemas() ([]Schema, error) {
+	entries, err := crds.ReadDir("crds")
+	if err != nil {
+		return nil, err
+	}
+
+	var schemas []Schema
+	for _, entry := range entries {
+		name := path.Join("crds", entry.Name())
+		data, err := crds.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		var crd customResourceDefinition
+		if err := yaml.Unmarshal(data, &crd); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+		for _, version := range crd.Spec.Versions {
+			schemas = append(schemas, Schema{
+				GroupVersionKind: schema.GroupVersionKind{
+					Group:   crd.Spec.Group,
+					Version: version.Name,
+					Kind:    crd.Spec.Names.Kind,
+				},
+				Namespaced:      crd.Spec.Scope == "Namespaced",
+				OpenAPIV3Schema: version.Schema.OpenAPIV3Schema,
+			})
+		}
+	}
+
+	sort.Slice(schemas, func(i, j int) bool {
+		if schemas[i].GroupVersionKind.Kind != schemas[j].GroupVersionKind.Kind {
+			return schemas[i].GroupVersionKind.Kind < schemas[j].GroupVersionKind.Kind
+		}
+		return schemas[i].GroupVersionKind.Version < schemas[j].GroupVersionKind.Version
+	})
+	return schemas, nil
+}
+
+// SchemaName returns the name of the schema in an OpenAPI document, e.g.
+// "com.vmware.imageregistry.v1alpha1.ContentLibrary".
+func SchemaName(gvk schema.GroupVersionKind) string {
+	return fmt.Sprintf("%s.%s.%s", reverseDomain(gvk.Group), gvk.Version, gvk.Kind)
+}
+
+// Document returns an OpenAPI v3 document whose components hold the schemas of every version of every kind
+// of the image registry API, keyed by SchemaName.
+func Document() (map[string]interface{}, error) {
+	schemas, err := Schemas()
+	if err != nil {
+		return nil, err
+	}
+
+	components := map[string]interface{}{}
+	for _, s := range schemas {
+		components[SchemaName(s.GroupVersionKind)] = s.OpenAPIV3Schema
+	}
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   "Image Registry API",
+			"version": v1alpha1.SchemeGroupVersion.Version,
+		},
+		"paths":      map[string]interface{}{},
+		"components": map[string]interface{}{"schemas": components},
+	}, nil
+}
+
+func reverseDomain(group string) string {
+	labels := strings.Split(group, ".")
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return strings.Join(labels, ".")
+}
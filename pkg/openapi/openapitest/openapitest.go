@@ -0,0 +1,332 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package openapitest checks objects of the image registry API against the structural schemas exported by
+// package openapi, so that tests can catch drift between the Go types, their kubebuilder markers and the
+// generated CRDs. The OpenAPI types, formats, enums, required fields, lengths, item counts and patterns of the
+// schemas are checked, but their CEL validation rules are not evaluated.
+package openapitest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1/install"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/openapi"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	loadOnce sync.Once
+	scheme   *runtime.Scheme
+	schemas  map[schema.GroupVersionKind]map[string]interface{}
+	loadErr  error
+)
+
+func load() {
+	scheme = runtime.NewScheme()
+	install.Install(scheme)
+
+	all, err := openapi.Schemas()
+	if err != nil {
+		loadErr = err
+		return
+	}
+	schemas = map[schema.GroupVersionKind]map[string]interface{}{}
+	for _, s := range all {
+		schemas[s.GroupVersionKind] = s.OpenAPIV3Schema
+	}
+}
+
+// Scheme returns a scheme the image registry API is installed in.
+func Scheme() *runtime.Scheme {
+	loadOnce.Do(load)
+	return scheme
+}
+
+// SchemaFor returns the OpenAPI v3 schema of the kind of obj. The kind is looked up in the image registry
+// scheme, so the type meta of obj does not need to be set.
+func SchemaFor(obj runtime.Object) (map[string]interface{}, error) {
+	loadOnce.Do(load)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+
+	gvks, _, err := scheme.ObjectKinds(obj)
+	if err != nil {
+		return nil, err
+	}
+	for _, gvk := range gvks {
+		if s, ok := schemas[gvk]; ok {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("no schema is exported for %v", gvks)
+}
+
+// Validate checks obj against the structural schema of its kind.
+func Validate(obj runtime.Object) (field.ErrorList, error) {
+	s, err := SchemaFor(obj)
+	if err != nil {
+		return nil, err
+	}
+	value, err := toJSONValue(obj)
+	if err != nil {
+		return nil, err
+	}
+	return validate(nil, s, value), nil
+}
+
+// RoundTrip encodes obj as JSON, prunes the fields the schema of its kind does not declare the way the API
+// server does, and decodes the result into into, which should be a new object of the same type. It returns
+// the paths of the pruned fields.
+func RoundTrip(obj, into runtime.Object) ([]string, error) {
+	s, err := SchemaFor(obj)
+	if err != nil {
+		return nil, err
+	}
+	value, err := toJSONValue(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var pruned []string
+	prune(nil, s, value, &pruned)
+	sort.Strings(pruned)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return pruned, json.Unmarshal(data, into)
+}
+
+// toJSONValue returns the JSON representation of obj, keeping numbers as json.Number so that 64-bit integers
+// survive the round-trip.
+func toJSONValue(obj runtime.Object) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	return value, decoder.Decode(&value)
+}
+
+// prune removes the fields of value that s does not declare, recording their paths in pruned. Like the
+// API server, it leaves the object metadata alone, since its schema does not declare its fields.
+func prune(path *field.Path, s map[string]interface{}, value interface{}, pruned *[]string) {
+	if preservesUnknownFields(s) {
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		properties, hasProperties := s["properties"].(map[string]interface{})
+		additional, hasAdditional := s["additionalProperties"].(map[string]interface{})
+		for key, child := range v {
+			switch {
+			case hasProperties:
+				property, ok := properties[key].(map[string]interface{})
+				if !ok {
+					delete(v, key)
+					*pruned = append(*pruned, path.Child(key).String())
+					continue
+				}
+				prune(path.Child(key), property, child, pruned)
+			case hasAdditional:
+				prune(path.Key(key), additional, child, pruned)
+			}
+		}
+	case []interface{}:
+		if items, ok := s["items"].(map[string]interface{}); ok {
+			for i, child := range v {
+				prune(path.Index(i), items, child, pruned)
+			}
+		}
+	}
+}
+
+func validate(path *field.Path, s map[string]interface{}, value interface{}) field.ErrorList {
+	// Like the API server, null values of fields that are not nullable are dropped instead of rejected.
+	if value == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	if enum, ok := s["enum"].([]interface{}); ok && !contains(enum, value) {
+		allErrs = append(allErrs, field.NotSupported(path, value, enumStrings(enum)))
+	}
+
+	if intOrString, _ := s["x-kubernetes-int-or-string"].(bool); intOrString {
+		if _, isString := value.(string); !isString && !isInteger(value) {
+			allErrs = append(allErrs, field.Invalid(path, value, "must be an integer or a string"))
+		}
+		return allErrs
+	}
+
+	switch s["type"] {
+	case "object":
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return append(allErrs, field.Invalid(path, value, "must be an object"))
+		}
+		allErrs = append(allErrs, validateObject(path, s, v)...)
+	case "array":
+		v, ok := value.([]interface{})
+		if !ok {
+			return append(allErrs, field.Invalid(path, value, "must be an array"))
+		}
+		if max, ok := s["maxItems"].(float64); ok && float64(len(v)) > max {
+			allErrs = append(allErrs, field.TooMany(path, len(v), int(max)))
+		}
+		if min, ok := s["minItems"].(float64); ok && float64(len(v)) < min {
+			allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("must have at least %d items", int(min))))
+		}
+		if items, ok := s["items"].(map[string]interface{}); ok {
+			for i, item := range v {
+				allErrs = append(allErrs, validate(path.Index(i), items, item)...)
+			}
+		}
+	case "string":
+		v, ok := value.(string)
+		if !ok {
+			return append(allErrs, field.Invalid(path, value, "must be a string"))
+		}
+		allErrs = append(allErrs, validateString(path, s, v)...)
+	case "integer":
+		if !isInteger(value) {
+			return append(allErrs, field.Invalid(path, value, "must be an integer"))
+		}
+		v, _ := value.(json.Number).Float64()
+		allErrs = append(allErrs, validateNumber(path, s, v)...)
+	case "number":
+		number, ok := value.(json.Number)
+		if !ok {
+			return append(allErrs, field.Invalid(path, value, "must be a number"))
+		}
+		v, err := number.Float64()
+		if err != nil {
+			return append(allErrs, field.Invalid(path, value, "must be a number"))
+		}
+		allErrs = append(allErrs, validateNumber(path, s, v)...)
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return append(allErrs, field.Invalid(path, value, "must be a boolean"))
+		}
+	}
+	return allErrs
+}
+
+func validateObject(path *field.Path, s map[string]interface{}, value map[string]interface{}) field.ErrorList {
+	var allErrs field.ErrorList
+
+	required, _ := s["required"].([]interface{})
+	for _, name := range required {
+		if value[name.(string)] == nil {
+			allErrs = append(allErrs, field.Required(path.Child(name.(string)), ""))
+		}
+	}
+
+	properties, _ := s["properties"].(map[string]interface{})
+	additional, _ := s["additionalProperties"].(map[string]interface{})
+	for key, child := range value {
+		if property, ok := properties[key].(map[string]interface{}); ok {
+			allErrs = append(allErrs, validate(path.Child(key), property, child)...)
+		} else if additional != nil {
+			allErrs = append(allErrs, validate(path.Key(key), additional, child)...)
+		}
+	}
+
+	if max, ok := s["maxProperties"].(float64); ok && float64(len(value)) > max {
+		allErrs = append(allErrs, field.TooMany(path, len(value), int(max)))
+	}
+	return allErrs
+}
+
+func validateString(path *field.Path, s map[string]interface{}, value string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	length := utf8.RuneCountInString(value)
+	if max, ok := s["maxLength"].(float64); ok && float64(length) > max {
+		allErrs = append(allErrs, field.TooLong(path, value, int(max)))
+	}
+	if min, ok := s["minLength"].(float64); ok && float64(length) < min {
+		allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("must be at least %d characters long", int(min))))
+	}
+	if pattern, ok := s["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(path, fmt.Errorf("invalid pattern %q: %w", pattern, err)))
+		} else if !re.MatchString(value) {
+			allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("must match %q", pattern)))
+		}
+	}
+
+	switch s["format"] {
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			allErrs = append(allErrs, field.Invalid(path, value, "must be an RFC 3339 date-time"))
+		}
+	case "byte":
+		if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(path, value, "must be base64 encoded"))
+		}
+	}
+	return allErrs
+}
+
+func validateNumber(path *field.Path, s map[string]interface{}, value float64) field.ErrorList {
+	var allErrs field.ErrorList
+	if max, ok := s["maximum"].(float64); ok && value > max {
+		allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("must be less than or equal to %v", max)))
+	}
+	if min, ok := s["minimum"].(float64); ok && value < min {
+		allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("must be greater than or equal to %v", min)))
+	}
+	return allErrs
+}
+
+func preservesUnknownFields(s map[string]interface{}) bool {
+	preserve, _ := s["x-kubernetes-preserve-unknown-fields"].(bool)
+	return preserve
+}
+
+func isInteger(value interface{}) bool {
+	number, ok := value.(json.Number)
+	if !ok {
+		return false
+	}
+	if _, err := number.Int64(); err == nil {
+		return true
+	}
+	v, err := number.Float64()
+	return err == nil && v == math.Trunc(v)
+}
+
+func contains(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if fmt.Sprint(v) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func enumStrings(values []interface{}) []string {
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		strs = append(strs, fmt.Sprint(v))
+	}
+	return strs
+}
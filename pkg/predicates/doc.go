@@ -0,0 +1,10 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package predicates implements filters of the update events of the image registry resources, so that the
+// controllers watching them only reconcile on meaningful changes rather than on every status heartbeat.
+// The filters do not depend on any controller framework; with controller-runtime they are wrapped as
+//
+//	predicate.Funcs{UpdateFunc: func(e event.UpdateEvent) bool {
+//		return predicates.ReadyTransition(e.ObjectOld, e.ObjectNew)
+//	}}
+package predicates
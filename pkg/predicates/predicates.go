@@ -0,0 +1,107 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package predicates
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/conditions"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpdateFunc returns true if the update of a resource from oldObj to newObj must be reconciled.
+type UpdateFunc func(oldObj, newObj metav1.Object) bool
+
+// Or returns an UpdateFunc that returns true if any of the given UpdateFuncs does.
+func Or(funcs ...UpdateFunc) UpdateFunc {
+	return func(oldObj, newObj metav1.Object) bool {
+		for _, f := range funcs {
+			if f(oldObj, newObj) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// SpecUUIDChanged returns true if the vCenter UUID in the spec of a ContentLibrary, ClusterContentLibrary,
+// ContentLibraryItem or ClusterContentLibraryItem changed. UUIDs that only differ in casing or surrounding
+// whitespace are equal. It returns false for resources of any other type.
+func SpecUUIDChanged(oldObj, newObj metav1.Object) bool {
+	oldUUID, ok := specUUID(oldObj)
+	if !ok {
+		return false
+	}
+	newUUID, ok := specUUID(newObj)
+	return ok && v1alpha1.NormalizeUUID(oldUUID) != v1alpha1.NormalizeUUID(newUUID)
+}
+
+// StatusContentVersionChanged returns true if the content version in the status of a ContentLibraryItem or
+// ClusterContentLibraryItem changed, i.e. if the files of the library item were changed in vCenter.
+// It returns false for resources of any other type.
+func StatusContentVersionChanged(oldObj, newObj metav1.Object) bool {
+	oldVersion, ok := statusContentVersion(oldObj)
+	if !ok {
+		return false
+	}
+	newVersion, ok := statusContentVersion(newObj)
+	return ok && oldVersion != newVersion
+}
+
+// ReadyTransition returns true if the resource became ready or stopped being ready, i.e. if the status of its
+// Ready condition changed. For library items, a change of Status.Ready is a transition as well.
+func ReadyTransition(oldObj, newObj metav1.Object) bool {
+	if oldReady, ok := statusReady(oldObj); ok {
+		if newReady, ok := statusReady(newObj); ok && oldReady != newReady {
+			return true
+		}
+	}
+	return readyConditionStatus(oldObj) != readyConditionStatus(newObj)
+}
+
+func specUUID(obj metav1.Object) (string, bool) {
+	switch o := obj.(type) {
+	case *v1alpha1.ContentLibrary:
+		return string(o.Spec.UUID), true
+	case *v1alpha1.ClusterContentLibrary:
+		return string(o.Spec.UUID), true
+	case *v1alpha1.ContentLibraryItem:
+		return string(o.Spec.UUID), true
+	case *v1alpha1.ClusterContentLibraryItem:
+		return string(o.Spec.UUID), true
+	}
+	return "", false
+}
+
+func statusContentVersion(obj metav1.Object) (string, bool) {
+	switch o := obj.(type) {
+	case *v1alpha1.ContentLibraryItem:
+		return o.Status.ContentVersion, true
+	case *v1alpha1.ClusterContentLibraryItem:
+		return o.Status.ContentVersion, true
+	}
+	return "", false
+}
+
+func statusReady(obj metav1.Object) (bool, bool) {
+	switch o := obj.(type) {
+	case *v1alpha1.ContentLibraryItem:
+		return o.Status.Ready, true
+	case *v1alpha1.ClusterContentLibraryItem:
+		return o.Status.Ready, true
+	}
+	return false, false
+}
+
+// readyConditionStatus returns the status of the Ready condition of obj, or an empty status if obj has no
+// Ready condition or no conditions at all.
+func readyConditionStatus(obj metav1.Object) corev1.ConditionStatus {
+	getter, ok := obj.(conditions.Getter)
+	if !ok {
+		return ""
+	}
+	if c := conditions.Get(getter, v1alpha1.ConditionReady); c != nil {
+		return c.Status
+	}
+	return ""
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package predicates_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/conditions"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/predicates"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const uuid = "dc1a7e76-4a30-4d5e-9a8b-3c1f4e3b2a10"
+
+func readyItem() *v1alpha1.ContentLibraryItem {
+	item := &v1alpha1.ContentLibraryItem{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "clitem"},
+		Spec:       v1alpha1.ContentLibraryItemSpec{UUID: uuid},
+		Status:     v1alpha1.ContentLibraryItemStatus{ContentVersion: "1", Ready: true},
+	}
+	conditions.MarkTrue(item, v1alpha1.ConditionReady)
+	return item
+}
+
+// heartbeat returns a copy of the item whose status only differs by the times reported on every
+// reconciliation.
+func heartbeat(item *v1alpha1.ContentLibraryItem) *v1alpha1.ContentLibraryItem {
+	updated := item.DeepCopy()
+	now := metav1.NewTime(time.Now().Add(time.Minute))
+	updated.Status.LastObservedTime = &now
+	updated.Status.LastDriftCheckTime = &now
+	updated.ResourceVersion = "2"
+	return updated
+}
+
+func TestPredicates(t *testing.T) {
+	tests := []struct {
+		name                  string
+		update                func(*v1alpha1.ContentLibraryItem)
+		uuidChanged           bool
+		contentVersionChanged bool
+		readyTransition       bool
+	}{
+		{
+			name:   "a heartbeat",
+			update: func(*v1alpha1.ContentLibraryItem) {},
+		},
+		{
+			name: "the Ready condition is set again with another message",
+			update: func(item *v1alpha1.ContentLibraryItem) {
+				conditions.Set(item, &v1alpha1.Condition{Type: v1alpha1.ConditionReady,
+					Status: corev1.ConditionTrue, Message: "checked again"})
+			},
+		},
+		{
+			name: "the UUID only differs in casing and whitespace",
+			update: func(item *v1alpha1.ContentLibraryItem) {
+				item.Spec.UUID = " DC1A7E76-4A30-4D5E-9A8B-3C1F4E3B2A10 "
+			},
+		},
+		{
+			name: "another UUID",
+			update: func(item *v1alpha1.ContentLibraryItem) {
+				item.Spec.UUID = "3f9b9d2e-5c1a-4d8e-9f2b-1a2b3c4d5e6f"
+			},
+			uuidChanged: true,
+		},
+		{
+			name:                  "another content version",
+			update:                func(item *v1alpha1.ContentLibraryItem) { item.Status.ContentVersion = "2" },
+			contentVersionChanged: true,
+		},
+		{
+			name: "the Ready condition becomes false",
+			update: func(item *v1alpha1.ContentLibraryItem) {
+				conditions.MarkFalse(item, v1alpha1.ConditionReady, "Failed", v1alpha1.ConditionSeverityError, "")
+			},
+			readyTransition: true,
+		},
+		{
+			name: "the Ready condition is removed",
+			update: func(item *v1alpha1.ContentLibraryItem) {
+				conditions.Delete(item, v1alpha1.ConditionReady)
+			},
+			readyTransition: true,
+		},
+		{
+			name:            "status.ready becomes false",
+			update:          func(item *v1alpha1.ContentLibraryItem) { item.Status.Ready = false },
+			readyTransition: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldItem := readyItem()
+			newItem := heartbeat(oldItem)
+			tt.update(newItem)
+
+			if changed := predicates.SpecUUIDChanged(oldItem, newItem); changed != tt.uuidChanged {
+				t.Errorf("expected SpecUUIDChanged to be %t", tt.uuidChanged)
+			}
+			if changed := predicates.StatusContentVersionChanged(oldItem, newItem); changed != tt.contentVersionChanged {
+				t.Errorf("expected StatusContentVersionChanged to be %t", tt.contentVersionChanged)
+			}
+			if transition := predicates.ReadyTransition(oldItem, newItem); transition != tt.readyTransition {
+				t.Errorf("expected ReadyTransition to be %t", tt.readyTransition)
+			}
+
+			or := predicates.Or(predicates.SpecUUIDChanged, predicates.StatusContentVersionChanged,
+				predicates.ReadyTransition)
+			if expected := tt.uuidChanged || tt.contentVersionChanged || tt.readyTransition; or(oldItem, newItem) != expected {
+				t.Errorf("expected Or to be %t", expected)
+			}
+		})
+	}
+}
+
+func TestReadyTransitionOfLibraries(t *testing.T) {
+	oldLibrary := &v1alpha1.ContentLibrary{Spec: v1alpha1.ContentLibrarySpec{UUID: uuid}}
+	conditions.MarkFalse(oldLibrary, v1alpha1.ConditionReady, "Syncing", v1alpha1.ConditionSeverityInfo, "")
+
+	newLibrary := oldLibrary.DeepCopy()
+	now := metav1.Now()
+	newLibrary.Status.LastObservedTime = &now
+	if predicates.ReadyTransition(oldLibrary, newLibrary) {
+		t.Error("expected a heartbeat not to be a transition")
+	}
+
+	conditions.MarkTrue(newLibrary, v1alpha1.ConditionReady)
+	if !predicates.ReadyTransition(oldLibrary, newLibrary) {
+		t.Error("expected a transition")
+	}
+	if predicates.StatusContentVersionChanged(oldLibrary, newLibrary) {
+		t.Error("expected libraries to have no content version")
+	}
+}
+
+func TestOtherTypes(t *testing.T) {
+	oldSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{UID: "1"}}
+	newSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{UID: "2"}}
+	if predicates.SpecUUIDChanged(oldSecret, newSecret) || predicates.StatusContentVersionChanged(oldSecret, newSecret) ||
+		predicates.ReadyTransition(oldSecret, newSecret) {
+		t.Error("expected changes of other types to be filtered out")
+	}
+}
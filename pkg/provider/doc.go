@@ -0,0 +1,8 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package provider defines the interface between the image registry controllers and the backend that stores
+// the libraries and library items, so that backends other than vCenter content libraries, e.g. OCI registries,
+// can be plugged in behind the same resources. The vCenter implementation is package vsphere, a separate
+// module, so that this module does not depend on a vCenter client; Fake is an in-memory implementation for
+// tests.
+package provider
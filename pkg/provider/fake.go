@@ -0,0 +1,113 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package provider
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+)
+
+// Fake is an in-memory Provider for tests. Synchronizing an item marks it as cached.
+// The zero value is not usable; use NewFake.
+type Fake struct {
+	mu        sync.Mutex
+	libraries map[v1alpha1.LibraryID]Library
+	items     map[v1alpha1.ItemID]Item
+	files     map[v1alpha1.ItemID][]v1alpha1.FileInfo
+	syncs     map[v1alpha1.ItemID]int
+
+	// SyncError, if set, is returned by SyncItem for existing items, instead of marking them cached.
+	SyncError error
+}
+
+var _ Provider = &Fake{}
+
+// NewFake returns an empty Fake.
+func NewFake() *Fake {
+	return &Fake{
+		libraries: map[v1alpha1.LibraryID]Library{},
+		items:     map[v1alpha1.ItemID]Item{},
+		files:     map[v1alpha1.ItemID][]v1alpha1.FileInfo{},
+		syncs:     map[v1alpha1.ItemID]int{},
+	}
+}
+
+// AddLibrary adds or replaces a library.
+func (f *Fake) AddLibrary(library Library) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.libraries[library.ID.Normalize()] = library
+}
+
+// AddItem adds or replaces a library item and its files.
+func (f *Fake) AddItem(item Item, files ...v1alpha1.FileInfo) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.items[item.ID.Normalize()] = item
+	f.files[item.ID.Normalize()] = append([]v1alpha1.FileInfo(nil), files...)
+}
+
+// SyncCount returns the number of times SyncItem was called successfully for the library item.
+func (f *Fake) SyncCount(id v1alpha1.ItemID) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.syncs[id.Normalize()]
+}
+
+// GetLibrary implements Provider.
+func (f *Fake) GetLibrary(_ context.Context, id v1alpha1.LibraryID) (*Library, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	library, ok := f.libraries[id.Normalize()]
+	if !ok {
+		return nil, notFound("library", id.String())
+	}
+	return &library, nil
+}
+
+// ListItems implements Provider. The items are ordered by ID.
+func (f *Fake) ListItems(_ context.Context, id v1alpha1.LibraryID) ([]Item, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.libraries[id.Normalize()]; !ok {
+		return nil, notFound("library", id.String())
+	}
+	var items []Item
+	for _, item := range f.items {
+		if item.LibraryID.Equal(id) {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return items, nil
+}
+
+// GetItemFiles implements Provider.
+func (f *Fake) GetItemFiles(_ context.Context, id v1alpha1.ItemID) ([]v1alpha1.FileInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.items[id.Normalize()]; !ok {
+		return nil, notFound("library item", id.String())
+	}
+	return append([]v1alpha1.FileInfo(nil), f.files[id.Normalize()]...), nil
+}
+
+// SyncItem implements Provider.
+func (f *Fake) SyncItem(_ context.Context, id v1alpha1.ItemID, _ bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	item, ok := f.items[id.Normalize()]
+	if !ok {
+		return notFound("library item", id.String())
+	}
+	if f.SyncError != nil {
+		return f.SyncError
+	}
+	item.Cached = true
+	f.items[id.Normalize()] = item
+	f.syncs[id.Normalize()]++
+	return nil
+}
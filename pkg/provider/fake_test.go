@@ -0,0 +1,115 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/provider"
+)
+
+const (
+	libraryID = v1alpha1.LibraryID("3f9b9d2e-5c1a-4d8e-9f2b-1a2b3c4d5e6f")
+	otherID   = v1alpha1.LibraryID("6ae2f2b4-0b1e-4f0c-9d8a-7c6b5a4f3e2d")
+	itemA     = v1alpha1.ItemID("1c2d4e6f-1a3b-4c5d-8e9f-0a1b2c3d4e5f")
+	itemB     = v1alpha1.ItemID("8c2d4e6f-1a3b-4c5d-8e9f-0a1b2c3d4e5f")
+)
+
+func newFake() *provider.Fake {
+	fake := provider.NewFake()
+	fake.AddLibrary(provider.Library{ID: libraryID, Name: "library", Type: v1alpha1.ContentLibraryTypeSubscribed})
+	fake.AddLibrary(provider.Library{ID: otherID, Name: "other", Type: v1alpha1.ContentLibraryTypeLocal})
+	fake.AddItem(provider.Item{ID: itemB, LibraryID: libraryID, Name: "b"},
+		v1alpha1.FileInfo{Name: "b.ovf", Size: 1024})
+	fake.AddItem(provider.Item{ID: itemA, LibraryID: libraryID, Name: "a"})
+	fake.AddItem(provider.Item{ID: "9c2d4e6f-1a3b-4c5d-8e9f-0a1b2c3d4e5f", LibraryID: otherID, Name: "other"})
+	return fake
+}
+
+func TestFakeGetLibrary(t *testing.T) {
+	fake := newFake()
+
+	library, err := fake.GetLibrary(context.Background(), "3F9B9D2E-5C1A-4D8E-9F2B-1A2B3C4D5E6F")
+	if err != nil || library.Name != "library" {
+		t.Errorf("expected the library to be found by its UUID in any case, got %+v, %v", library, err)
+	}
+
+	if _, err := fake.GetLibrary(context.Background(), "00000000-0000-0000-0000-000000000000"); !provider.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestFakeListItems(t *testing.T) {
+	fake := newFake()
+
+	items, err := fake.ListItems(context.Background(), libraryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("expected the items of the library ordered by ID, got %v", names)
+	}
+
+	if _, err := fake.ListItems(context.Background(), "00000000-0000-0000-0000-000000000000"); !provider.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestFakeGetItemFiles(t *testing.T) {
+	fake := newFake()
+
+	files, err := fake.GetItemFiles(context.Background(), itemB)
+	if err != nil || len(files) != 1 || files[0].Name != "b.ovf" {
+		t.Fatalf("expected the files of the item, got %v, %v", files, err)
+	}
+	files[0].Name = "changed"
+	if files, _ := fake.GetItemFiles(context.Background(), itemB); files[0].Name != "b.ovf" {
+		t.Error("expected the returned files to be a copy")
+	}
+
+	if files, err := fake.GetItemFiles(context.Background(), itemA); err != nil || len(files) != 0 {
+		t.Errorf("expected no files, got %v, %v", files, err)
+	}
+	if _, err := fake.GetItemFiles(context.Background(), "00000000-0000-0000-0000-000000000000"); !provider.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestFakeSyncItem(t *testing.T) {
+	fake := newFake()
+	ctx := context.Background()
+
+	if err := fake.SyncItem(ctx, itemA, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := fake.SyncItem(ctx, itemA, true); err != nil {
+		t.Fatal(err)
+	}
+	if count := fake.SyncCount(itemA); count != 2 {
+		t.Errorf("expected 2 synchronizations, got %d", count)
+	}
+	items, _ := fake.ListItems(ctx, libraryID)
+	if !items[0].Cached || items[1].Cached {
+		t.Errorf("expected only the synchronized item to be cached, got %+v", items)
+	}
+
+	fake.SyncError = errors.New("sync failed")
+	if err := fake.SyncItem(ctx, itemB, false); !errors.Is(err, fake.SyncError) {
+		t.Errorf("expected the sync error, got %v", err)
+	}
+	if count := fake.SyncCount(itemB); count != 0 {
+		t.Errorf("expected a failed synchronization not to be counted, got %d", count)
+	}
+
+	if err := fake.SyncItem(ctx, "00000000-0000-0000-0000-000000000000", false); !provider.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
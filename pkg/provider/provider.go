@@ -0,0 +1,94 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+)
+
+// ErrNotFound is returned, possibly wrapped, when a library or library item does not exist in the backend.
+var ErrNotFound = errors.New("not found")
+
+// IsNotFound returns true if err indicates that a library or library item does not exist in the backend.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// Library describes a library as reported by the backend.
+type Library struct {
+	// ID identifies the library in the backend.
+	ID v1alpha1.LibraryID
+
+	// Name is the name of the library.
+	Name string
+
+	// Description is the description of the library.
+	Description string
+
+	// Type is the type of the library.
+	Type v1alpha1.ContentLibraryType
+
+	// Version identifies the version of the metadata of the library.
+	Version string
+
+	// Capabilities describes the features the backend supports for the library, or nil if they are unknown.
+	Capabilities *v1alpha1.ProviderCapabilities
+}
+
+// Item describes a library item as reported by the backend.
+type Item struct {
+	// ID identifies the library item in the backend.
+	ID v1alpha1.ItemID
+
+	// LibraryID identifies the library the item belongs to.
+	LibraryID v1alpha1.LibraryID
+
+	// Name is the name of the library item.
+	Name string
+
+	// Description is the description of the library item.
+	Description string
+
+	// Type is the type of the library item.
+	Type v1alpha1.ContentLibraryItemType
+
+	// MetadataVersion identifies the version of the metadata of the library item.
+	MetadataVersion string
+
+	// ContentVersion identifies the version of the content of the library item.
+	ContentVersion string
+
+	// Size is the size of the library item in bytes.
+	Size int64
+
+	// Cached indicates whether the content of the library item is stored by the backend.
+	Cached bool
+}
+
+// Provider is a backend that stores libraries and library items.
+// Implementations must be safe for concurrent use.
+type Provider interface {
+	// GetLibrary returns the library identified by id, or an error wrapping ErrNotFound if it does not exist.
+	GetLibrary(ctx context.Context, id v1alpha1.LibraryID) (*Library, error)
+
+	// ListItems returns the items of the library identified by id, or an error wrapping ErrNotFound if the
+	// library does not exist.
+	ListItems(ctx context.Context, id v1alpha1.LibraryID) ([]Item, error)
+
+	// GetItemFiles returns the files of the library item identified by id, or an error wrapping ErrNotFound if
+	// the library item does not exist.
+	GetItemFiles(ctx context.Context, id v1alpha1.ItemID) ([]v1alpha1.FileInfo, error)
+
+	// SyncItem starts the synchronization of the content of the library item identified by id with its
+	// source, e.g. the publisher of a subscribed library. If force is true, the content is synchronized even
+	// if it is already stored. It returns an error wrapping ErrNotFound if the library item does not exist.
+	SyncItem(ctx context.Context, id v1alpha1.ItemID, force bool) error
+}
+
+func notFound(kind, id string) error {
+	return fmt.Errorf("%s %s: %w", kind, id, ErrNotFound)
+}
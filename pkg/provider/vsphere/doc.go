@@ -0,0 +1,6 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package vsphere is the reference implementation of provider.Provider, backed by the content library API of
+// vCenter through govmomi. It is a separate module, so that importing the image registry API does not pull in
+// a vCenter client.
+package vsphere
@@ -0,0 +1,131 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/provider"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/rest"
+)
+
+// vCenterLibraryTypes maps the library types reported by vCenter to the types of the API.
+var vCenterLibraryTypes = map[string]v1alpha1.ContentLibraryType{
+	"LOCAL":      v1alpha1.ContentLibraryTypeLocal,
+	"SUBSCRIBED": v1alpha1.ContentLibraryTypeSubscribed,
+}
+
+// Provider is a provider.Provider backed by the content libraries of a vCenter.
+type Provider struct {
+	libraries *library.Manager
+}
+
+var _ provider.Provider = &Provider{}
+
+// NewProvider returns a Provider that uses the given vCenter REST client, which must be logged in.
+func NewProvider(client *rest.Client) *Provider {
+	return &Provider{libraries: library.NewManager(client)}
+}
+
+// GetLibrary implements provider.Provider. The capabilities of the library are not reported.
+func (p *Provider) GetLibrary(ctx context.Context, id v1alpha1.LibraryID) (*provider.Library, error) {
+	lib, err := p.libraries.GetLibraryByID(ctx, id.String())
+	if err != nil {
+		return nil, wrapError(err, "library", id.String())
+	}
+	return &provider.Library{
+		ID:          v1alpha1.LibraryID(lib.ID),
+		Name:        lib.Name,
+		Description: lib.Description,
+		Type:        vCenterLibraryTypes[strings.ToUpper(lib.Type)],
+		Version:     lib.Version,
+	}, nil
+}
+
+// ListItems implements provider.Provider. The type of the items vCenter reports no type for is detected
+// without their files, so they are reported as v1alpha1.ContentLibraryItemTypeFile.
+func (p *Provider) ListItems(ctx context.Context, id v1alpha1.LibraryID) ([]provider.Item, error) {
+	libItems, err := p.libraries.GetLibraryItems(ctx, id.String())
+	if err != nil {
+		return nil, wrapError(err, "library", id.String())
+	}
+	items := make([]provider.Item, 0, len(libItems))
+	for _, item := range libItems {
+		items = append(items, provider.Item{
+			ID:              v1alpha1.ItemID(item.ID),
+			LibraryID:       v1alpha1.LibraryID(item.LibraryID),
+			Name:            item.Name,
+			Description:     item.Description,
+			Type:            v1alpha1.DetectItemType(item.Type, nil),
+			MetadataVersion: item.MetadataVersion,
+			ContentVersion:  item.ContentVersion,
+			Size:            item.Size,
+			Cached:          item.Cached,
+		})
+	}
+	return items, nil
+}
+
+// GetItemFiles implements provider.Provider.
+func (p *Provider) GetItemFiles(ctx context.Context, id v1alpha1.ItemID) ([]v1alpha1.FileInfo, error) {
+	libFiles, err := p.libraries.ListLibraryItemFiles(ctx, id.String())
+	if err != nil {
+		return nil, wrapError(err, "library item", id.String())
+	}
+	files := make([]v1alpha1.FileInfo, 0, len(libFiles))
+	for _, file := range libFiles {
+		files = append(files, fileInfo(file))
+	}
+	return files, nil
+}
+
+// SyncItem implements provider.Provider.
+func (p *Provider) SyncItem(ctx context.Context, id v1alpha1.ItemID, force bool) error {
+	item, err := p.libraries.GetLibraryItem(ctx, id.String())
+	if err != nil {
+		return wrapError(err, "library item", id.String())
+	}
+	if err := p.libraries.SyncLibraryItem(ctx, item, force); err != nil {
+		return wrapError(err, "library item", id.String())
+	}
+	return nil
+}
+
+// fileInfo converts a file of a library item reported by vCenter.
+func fileInfo(file library.File) v1alpha1.FileInfo {
+	info := v1alpha1.FileInfo{
+		Name:    file.Name,
+		Version: file.Version,
+	}
+	if file.Size != nil {
+		info.Size = *file.Size
+	}
+	if file.Cached != nil {
+		info.Cached = *file.Cached
+	}
+	if file.Checksum != nil && file.Checksum.Checksum != "" {
+		info.Checksum = &v1alpha1.FileChecksum{
+			Algorithm: v1alpha1.ChecksumAlgorithm(strings.ToUpper(file.Checksum.Algorithm)),
+			Value:     file.Checksum.Checksum,
+		}
+	}
+	return info
+}
+
+// notFoundStatus is the status line of the responses of vCenter for objects that do not exist.
+var notFoundStatus = fmt.Sprintf("%d %s", http.StatusNotFound, http.StatusText(http.StatusNotFound))
+
+// wrapError wraps the errors of vCenter, and provider.ErrNotFound if the object does not exist. The REST
+// client of govmomi does not export the errors it returns for unexpected status codes, which end with the
+// status line of the response, so the status is read from the message.
+func wrapError(err error, kind, id string) error {
+	if strings.HasSuffix(err.Error(), ": "+notFoundStatus) {
+		return fmt.Errorf("%s %s: %w", kind, id, provider.ErrNotFound)
+	}
+	return fmt.Errorf("%s %s: %w", kind, id, err)
+}
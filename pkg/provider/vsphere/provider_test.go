@@ -0,0 +1,122 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package vsphere
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/provider"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+func TestFileInfo(t *testing.T) {
+	size := int64(1024)
+	cached := true
+
+	tests := []struct {
+		name     string
+		file     library.File
+		expected v1alpha1.FileInfo
+	}{
+		{
+			name:     "a file without size and cache status",
+			file:     library.File{Name: "ubuntu.ovf", Version: "1"},
+			expected: v1alpha1.FileInfo{Name: "ubuntu.ovf", Version: "1"},
+		},
+		{
+			name: "a cached file with a checksum",
+			file: library.File{
+				Name:     "ubuntu-disk1.vmdk",
+				Version:  "2",
+				Size:     &size,
+				Cached:   &cached,
+				Checksum: &library.Checksum{Algorithm: "sha256", Checksum: "abc"},
+			},
+			expected: v1alpha1.FileInfo{
+				Name:     "ubuntu-disk1.vmdk",
+				Version:  "2",
+				Size:     size,
+				Cached:   true,
+				Checksum: &v1alpha1.FileChecksum{Algorithm: v1alpha1.ChecksumAlgorithmSHA256, Value: "abc"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if info := fileInfo(tt.file); !reflect.DeepEqual(info, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, info)
+			}
+		})
+	}
+}
+
+// newTestProvider returns a Provider whose vCenter responds to every request with the given status code.
+func newTestProvider(t *testing.T, statusCode int) *Provider {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+
+	u, err := soap.ParseURL(server.URL)
+	if err != nil {
+		t.Fatalf("failed to parse the server URL: %v", err)
+	}
+	return NewProvider(rest.NewClient(&vim25.Client{Client: soap.NewClient(u, true)}))
+}
+
+func TestWrapError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		notFound   bool
+	}{
+		{
+			name:       "a missing library",
+			statusCode: http.StatusNotFound,
+			notFound:   true,
+		},
+		{
+			name:       "an unauthorized request",
+			statusCode: http.StatusUnauthorized,
+		},
+		{
+			name:       "a server error",
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTestProvider(t, tt.statusCode).GetLibrary(context.Background(), "lib-1")
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if notFound := provider.IsNotFound(err); notFound != tt.notFound {
+				t.Errorf("expected IsNotFound to be %t, got %t for %v", tt.notFound, notFound, err)
+			}
+			if !strings.HasPrefix(err.Error(), "library lib-1: ") {
+				t.Errorf("expected the error to name the library, got %v", err)
+			}
+		})
+	}
+}
+
+func TestWrapErrorKeepsOtherErrors(t *testing.T) {
+	cause := errors.New("connection refused")
+	err := wrapError(cause, "library item", "item-1")
+	if !errors.Is(err, cause) || provider.IsNotFound(err) {
+		t.Errorf("expected %v to wrap only %v", err, cause)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package rbac defines the resources and verbs of the image registry API, and the aggregated ClusterRoles
+// that grant access to them through the default view, edit and admin roles, as well as the ClusterRoles bound
+// to specific personas.
+package rbac
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The resources of the image registry API.
+const (
+	ContentLibraries                     = "contentlibraries"
+	ContentLibraryItems                  = "contentlibraryitems"
+	ContentLibraryItemFileUploads        = "contentlibraryitemfileuploads"
+	ContentLibraryItemSummaries          = "contentlibraryitemsummaries"
+	ContentLibraryItemImportRequests     = "contentlibraryitemimportrequests"
+	ContentLibraryImportSets             = "contentlibraryimportsets"
+	ImageAliases                         = "imagealiases"
+	ContentLibraryItemVersions           = "contentlibraryitemversions"
+	ContentLibraryReplications           = "contentlibraryreplications"
+	ContentLibraryItemValidationRequests = "contentlibraryitemvalidationrequests"
+	ContentLibraryItemVolumeRequests     = "contentlibraryitemvolumerequests"
+	ImageFamilies                        = "imagefamilies"
+	ContentLibrarySyncRequests           = "contentlibrarysyncrequests"
+	ClusterContentLibraries              = "clustercontentlibraries"
+	ClusterContentLibraryItems           = "clustercontentlibraryitems"
+	ClusterContentLibraryItemSummaries   = "clustercontentlibraryitemsummaries"
+	ContentLibraryConfigurations         = "contentlibraryconfigurations"
+)
+
+// The verbs used in the image registry roles.
+const (
+	VerbGet              = "get"
+	VerbList             = "list"
+	VerbWatch            = "watch"
+	VerbCreate           = "create"
+	VerbUpdate           = "update"
+	VerbPatch            = "patch"
+	VerbDelete           = "delete"
+	VerbDeleteCollection = "deletecollection"
+)
+
+// The labels that aggregate a ClusterRole into the default user-facing roles.
+const (
+	AggregateToViewLabelKey  = "rbac.authorization.k8s.io/aggregate-to-view"
+	AggregateToEditLabelKey  = "rbac.authorization.k8s.io/aggregate-to-edit"
+	AggregateToAdminLabelKey = "rbac.authorization.k8s.io/aggregate-to-admin"
+)
+
+// The names of the aggregated ClusterRoles.
+const (
+	ViewClusterRoleName  = "imageregistry-view"
+	EditClusterRoleName  = "imageregistry-edit"
+	AdminClusterRoleName = "imageregistry-admin"
+)
+
+// SyncTriggerClusterRoleName is the name of the ClusterRole that grants the right to trigger the
+// synchronization of libraries.
+const SyncTriggerClusterRoleName = "imageregistry-sync-trigger"
+
+var (
+	// ReadVerbs are the verbs that grant read-only access to a resource.
+	ReadVerbs = []string{VerbGet, VerbList, VerbWatch}
+
+	// WriteVerbs are the verbs that grant write access to a resource.
+	WriteVerbs = []string{VerbCreate, VerbUpdate, VerbPatch, VerbDelete, VerbDeleteCollection}
+
+	// SyncTriggerVerbs are the verbs that grant the right to trigger synchronizations with
+	// ContentLibrarySyncRequests and to follow their progress.
+	SyncTriggerVerbs = []string{VerbCreate, VerbGet, VerbList, VerbWatch}
+
+	// ViewResources are the namespaced resources that users with the view role can read.
+	ViewResources = []string{
+		ContentLibraries,
+		ContentLibraryItems,
+		ContentLibraryItemFileUploads,
+		ContentLibraryItemSummaries,
+		ContentLibraryItemImportRequests,
+		ContentLibraryImportSets,
+		ImageAliases,
+		ContentLibraryItemVersions,
+		ContentLibraryReplications,
+		ContentLibraryItemValidationRequests,
+		ContentLibraryItemVolumeRequests,
+		ImageFamilies,
+		ContentLibrarySyncRequests,
+	}
+
+	// EditResources are the namespaced resources that users with the edit role can modify.
+	EditResources = []string{
+		ContentLibraryItems,
+		ContentLibraryItemFileUploads,
+		ContentLibraryItemImportRequests,
+		ContentLibraryImportSets,
+		ImageAliases,
+		ContentLibraryReplications,
+		ContentLibraryItemValidationRequests,
+		ContentLibraryItemVolumeRequests,
+		ImageFamilies,
+		ContentLibrarySyncRequests,
+	}
+
+	// AdminResources are the namespaced resources that users with the admin role can modify, in addition
+	// to the EditResources.
+	AdminResources = []string{
+		ContentLibraries,
+	}
+)
+
+// AggregatedClusterRoles returns the ClusterRoles that aggregate access to the image registry API into the
+// default view, edit and admin roles.
+func AggregatedClusterRoles() []rbacv1.ClusterRole {
+	return []rbacv1.ClusterRole{
+		clusterRole(ViewClusterRoleName, map[string]string{AggregateToViewLabelKey: "true"},
+			policyRule(ReadVerbs, ViewResources)),
+		clusterRole(EditClusterRoleName, map[string]string{AggregateToEditLabelKey: "true"},
+			policyRule(WriteVerbs, EditResources)),
+		clusterRole(AdminClusterRoleName, map[string]string{AggregateToAdminLabelKey: "true"},
+			policyRule(WriteVerbs, AdminResources)),
+	}
+}
+
+// SyncTriggerClusterRole returns the ClusterRole that grants the right to trigger the synchronization of the
+// libraries of a namespace, without the right to update the libraries themselves. It is not aggregated into
+// the default roles, but bound to the personas that trigger synchronizations with a RoleBinding.
+func SyncTriggerClusterRole() rbacv1.ClusterRole {
+	return clusterRole(SyncTriggerClusterRoleName, nil,
+		policyRule(ReadVerbs, []string{ContentLibraries, ContentLibraryItems}),
+		policyRule(SyncTriggerVerbs, []string{ContentLibrarySyncRequests}))
+}
+
+// ClusterRoles returns every ClusterRole of the image registry API: the aggregated ClusterRoles and the
+// ClusterRoles bound to specific personas.
+func ClusterRoles() []rbacv1.ClusterRole {
+	return append(AggregatedClusterRoles(), SyncTriggerClusterRole())
+}
+
+func clusterRole(name string, labels map[string]string, rules ...rbacv1.PolicyRule) rbacv1.ClusterRole {
+	return rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+			Kind:       "ClusterRole",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Rules: rules,
+	}
+}
+
+func policyRule(verbs, resources []string) rbacv1.PolicyRule {
+	return rbacv1.PolicyRule{
+		APIGroups: []string{v1alpha1.GroupName},
+		Resources: resources,
+		Verbs:     verbs,
+	}
+}
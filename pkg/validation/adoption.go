@@ -0,0 +1,37 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	adoptionSpecChangedMessage  = "the spec cannot be changed while the resource is being adopted"
+	adoptedFromImmutableMessage = "the adoption annotation is immutable once set"
+)
+
+var adoptedFromPath = field.NewPath("metadata", "annotations").Key(v1alpha1.AdoptedFromAnnotationKey)
+
+// ValidateAdoptionUpdate validates an update of any image registry resource against the adoption rules:
+// the update that adopts a resource, i.e. adds the AdoptedFromAnnotationKey annotation, must not change its
+// spec, and the annotation cannot be changed or removed afterwards. The specs of the new and old resources
+// are passed separately since the resource kinds have no common spec type.
+func ValidateAdoptionUpdate(newObj, oldObj metav1.Object, newSpec, oldSpec interface{}) field.ErrorList {
+	var allErrs field.ErrorList
+
+	newFrom, newAdopted := v1alpha1.AdoptedFrom(newObj)
+	oldFrom, oldAdopted := v1alpha1.AdoptedFrom(oldObj)
+
+	switch {
+	case oldAdopted && (!newAdopted || newFrom != oldFrom):
+		allErrs = append(allErrs, field.Forbidden(adoptedFromPath, adoptedFromImmutableMessage))
+	case !oldAdopted && newAdopted && !apiequality.Semantic.DeepEqual(newSpec, oldSpec):
+		allErrs = append(allErrs, field.Forbidden(specPath, adoptionSpecChangedMessage))
+	}
+
+	return allErrs
+}
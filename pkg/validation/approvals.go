@@ -0,0 +1,102 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var approvalsPath = field.NewPath("metadata", "annotations").Key(v1alpha1.ApprovalsAnnotationKey)
+
+// ApproverAllowList lists the users allowed to change the v1alpha1.ApprovalsAnnotationKey annotation of
+// library items. It is configured by the component running the webhook.
+type ApproverAllowList struct {
+	// Users are the user names of the users allowed to approve content.
+	Users []string
+
+	// Groups are the groups whose members are allowed to approve content.
+	Groups []string
+}
+
+// Allows returns true if user is allowed to change the approvals of library items.
+func (allowList ApproverAllowList) Allows(user authenticationv1.UserInfo) bool {
+	for _, allowed := range allowList.Users {
+		if user.Username == allowed {
+			return true
+		}
+	}
+	for _, allowed := range allowList.Groups {
+		for _, group := range user.Groups {
+			if group == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateApprovals validates that the creation or update of a ContentLibraryItem or ClusterContentLibraryItem
+// by user only changes its v1alpha1.ApprovalsAnnotationKey annotation if allowList allows the user, and that
+// every approval the user adds or changes records the user as its approver, so that approvals cannot be
+// forged on behalf of someone else. The oldObj is nil on creation. Resources of any other kind are not
+// restricted.
+func ValidateApprovals(newObj, oldObj metav1.Object, user authenticationv1.UserInfo,
+	allowList ApproverAllowList) field.ErrorList {
+	if !isApprovable(newObj) {
+		return nil
+	}
+	var oldValue string
+	if isApprovable(oldObj) {
+		oldValue = oldObj.GetAnnotations()[v1alpha1.ApprovalsAnnotationKey]
+	}
+	if newObj.GetAnnotations()[v1alpha1.ApprovalsAnnotationKey] == oldValue {
+		return nil
+	}
+
+	if !allowList.Allows(user) {
+		return field.ErrorList{field.Forbidden(approvalsPath, "can only be changed by the approver allow-list")}
+	}
+
+	approvals, err := v1alpha1.Approvals(newObj)
+	if err != nil {
+		return field.ErrorList{field.Invalid(approvalsPath, newObj.GetAnnotations()[v1alpha1.ApprovalsAnnotationKey], err.Error())}
+	}
+	var oldApprovals []v1alpha1.Approval
+	if isApprovable(oldObj) {
+		// Approvals that cannot be parsed were never accepted by the webhook, so they are not carried over.
+		oldApprovals, _ = v1alpha1.Approvals(oldObj)
+	}
+
+	var allErrs field.ErrorList
+	for i, approval := range approvals {
+		if approval.Approver != user.Username && !containsApproval(oldApprovals, approval) {
+			allErrs = append(allErrs, field.Forbidden(approvalsPath.Index(i).Child("approver"),
+				fmt.Sprintf("user %s cannot record an approval of %s", user.Username, approval.Approver)))
+		}
+	}
+	return allErrs
+}
+
+func isApprovable(obj metav1.Object) bool {
+	switch item := obj.(type) {
+	case *v1alpha1.ContentLibraryItem:
+		return item != nil
+	case *v1alpha1.ClusterContentLibraryItem:
+		return item != nil
+	}
+	return false
+}
+
+func containsApproval(approvals []v1alpha1.Approval, approval v1alpha1.Approval) bool {
+	for _, a := range approvals {
+		if a.Approver == approval.Approver && a.Digest == approval.Digest && a.Time.Equal(&approval.Time) {
+			return true
+		}
+	}
+	return false
+}
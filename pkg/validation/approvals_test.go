@@ -0,0 +1,78 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/validation"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func approvedItem(t *testing.T, approvers ...string) *v1alpha1.ContentLibraryItem {
+	item := &v1alpha1.ContentLibraryItem{}
+	for _, approver := range approvers {
+		if err := v1alpha1.AddApproval(item, approver, "sha256:abc", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return item
+}
+
+func TestValidateApprovals(t *testing.T) {
+	allowList := validation.ApproverAllowList{Groups: []string{"image-approvers"}}
+	alice := authenticationv1.UserInfo{Username: "alice", Groups: []string{"image-approvers"}}
+	bob := authenticationv1.UserInfo{Username: "bob", Groups: []string{"image-approvers"}}
+	tenant := authenticationv1.UserInfo{Username: "tenant"}
+
+	invalidItem := &v1alpha1.ContentLibraryItem{}
+	invalidItem.Annotations = map[string]string{v1alpha1.ApprovalsAnnotationKey: "not json"}
+
+	approvals := "metadata.annotations[" + v1alpha1.ApprovalsAnnotationKey + "]"
+
+	tests := []struct {
+		name     string
+		newObj   metav1.Object
+		oldObj   metav1.Object
+		user     authenticationv1.UserInfo
+		expected []fieldError
+	}{
+		{name: "an approver approves", newObj: approvedItem(t, "alice"), oldObj: approvedItem(t), user: alice},
+		{name: "an approver approves on creation", newObj: approvedItem(t, "alice"), user: alice},
+		{
+			name:     "another user approves",
+			newObj:   approvedItem(t, "tenant"),
+			oldObj:   approvedItem(t),
+			user:     tenant,
+			expected: []fieldError{forbidden(approvals)},
+		},
+		{
+			name:     "an approver approves for someone else",
+			newObj:   approvedItem(t, "bob", "alice"),
+			oldObj:   approvedItem(t),
+			user:     bob,
+			expected: []fieldError{forbidden(approvals + "[1].approver")},
+		},
+		{name: "an approver keeps the approvals of others", newObj: approvedItem(t, "alice", "bob"), oldObj: approvedItem(t, "alice"), user: bob},
+		{name: "an approver removes an approval", newObj: approvedItem(t), oldObj: approvedItem(t, "alice"), user: bob},
+		{name: "another user leaves the approvals alone", newObj: approvedItem(t, "alice"), oldObj: approvedItem(t, "alice"), user: tenant},
+		{
+			name:     "an invalid annotation",
+			newObj:   invalidItem,
+			oldObj:   approvedItem(t),
+			user:     alice,
+			expected: []fieldError{invalid(approvals)},
+		},
+		{name: "another kind", newObj: &v1alpha1.ContentLibrary{}, user: tenant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validation.ValidateApprovals(tt.newObj, tt.oldObj, tt.user, allowList)
+			expectFieldErrors(t, errs, tt.expected...)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	readOnlyClusterLibraryMessage = "can only be set for items of a writable ClusterContentLibrary"
+)
+
+// ValidateClusterContentLibrary validates the creation or update of a ClusterContentLibrary.
+func ValidateClusterContentLibrary(library *v1alpha1.ClusterContentLibrary) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if library.Spec.AllowedNamespaces != nil {
+		allowedPath := specPath.Child("allowedNamespaces")
+		if !library.Spec.Writable {
+			allErrs = append(allErrs, field.Forbidden(allowedPath, "can only be set for a writable ClusterContentLibrary"))
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(library.Spec.AllowedNamespaces, allowedPath)...)
+	}
+
+	// The type of the library is only observed in its status, so it cannot be checked by the CRD schema
+	// without rejecting the status updates of the operator.
+	if library.Status.Type == v1alpha1.ContentLibraryTypeSubscribed && library.Spec.Writable {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("writable"), v1alpha1.SubscribedLibraryWritableMessage))
+	}
+
+	return allErrs
+}
+
+// ClusterContentLibraryAllowsNamespace returns true if library items can be imported into the library from
+// the namespace, i.e. if the library is writable and its spec.allowedNamespaces selects the namespace. It
+// returns false if the library or the namespace is nil.
+func ClusterContentLibraryAllowsNamespace(library *v1alpha1.ClusterContentLibrary, namespace *corev1.Namespace) bool {
+	if library == nil || namespace == nil || !library.Spec.Writable || library.Spec.AllowedNamespaces == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(library.Spec.AllowedNamespaces)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(namespace.Labels))
+}
+
+// ValidateClusterContentLibraryItem validates the creation of a ClusterContentLibraryItem that belongs to the
+// given library. The library may be nil if it does not exist yet.
+func ValidateClusterContentLibraryItem(item *v1alpha1.ClusterContentLibraryItem,
+	library *v1alpha1.ClusterContentLibrary) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !isClusterWritable(library) {
+		if item.Spec.Name != "" {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("name"), readOnlyClusterLibraryMessage))
+		}
+		if item.Spec.Description != "" {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("description"), readOnlyClusterLibraryMessage))
+		}
+		if len(item.Spec.CustomMetadata) > 0 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("customMetadata"), readOnlyClusterLibraryMessage))
+		}
+	}
+
+	allErrs = append(allErrs, validateCustomMetadata(item.Spec.CustomMetadata)...)
+
+	return allErrs
+}
+
+// ValidateClusterContentLibraryItemUpdate validates an update of a ClusterContentLibraryItem that belongs to
+// the given library. The library may be nil if it no longer exists.
+func ValidateClusterContentLibraryItemUpdate(newItem, oldItem *v1alpha1.ClusterContentLibraryItem,
+	library *v1alpha1.ClusterContentLibrary) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if newItem.Spec.UUID != oldItem.Spec.UUID {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("uuid"), newItem.Spec.UUID, v1alpha1.UUIDImmutableMessage))
+	}
+
+	if !isClusterWritable(library) {
+		if newItem.Spec.Name != oldItem.Spec.Name {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("name"), readOnlyClusterLibraryMessage))
+		}
+		if newItem.Spec.Description != oldItem.Spec.Description {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("description"), readOnlyClusterLibraryMessage))
+		}
+		if !apiequality.Semantic.DeepEqual(newItem.Spec.CustomMetadata, oldItem.Spec.CustomMetadata) {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("customMetadata"), readOnlyClusterLibraryMessage))
+		}
+	}
+
+	allErrs = append(allErrs, validateCustomMetadata(newItem.Spec.CustomMetadata)...)
+
+	return allErrs
+}
+
+func isClusterWritable(library *v1alpha1.ClusterContentLibrary) bool {
+	return library != nil && library.Spec.Writable
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func writableClusterLibrary() *v1alpha1.ClusterContentLibrary {
+	return &v1alpha1.ClusterContentLibrary{
+		ObjectMeta: metav1.ObjectMeta{Name: "golden-images"},
+		Spec: v1alpha1.ClusterContentLibrarySpec{
+			Writable:          true,
+			AllowedNamespaces: &metav1.LabelSelector{},
+		},
+	}
+}
+
+func TestValidateClusterContentLibrarySubscribedWritable(t *testing.T) {
+	library := writableClusterLibrary()
+	expectFieldErrors(t, validation.ValidateClusterContentLibrary(library))
+
+	library.Status.Type = v1alpha1.ContentLibraryTypeSubscribed
+	expectFieldErrors(t, validation.ValidateClusterContentLibrary(library), forbidden("spec.writable"))
+}
+
+func TestClusterContentLibraryWithoutNamespace(t *testing.T) {
+	library := writableClusterLibrary()
+	if validation.ClusterContentLibraryAllowsNamespace(library, nil) {
+		t.Error("expected a nil namespace not to be allowed")
+	}
+
+	request := &v1alpha1.ContentLibraryItemImportRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "import"},
+		Spec: v1alpha1.ContentLibraryItemImportRequestSpec{
+			Target: v1alpha1.ContentLibraryItemImportRequestTarget{ClusterContentLibraryRef: library.Name},
+		},
+	}
+	expectFieldErrors(t, validation.ValidateContentLibraryItemImportRequest(request, library, nil),
+		forbidden("spec.target.clusterContentLibraryRef"))
+}
@@ -0,0 +1,305 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	urlRegexp        = regexp.MustCompile(v1alpha1.URLPattern)
+	thumbprintRegexp = regexp.MustCompile(v1alpha1.ThumbprintPattern)
+)
+
+// LibraryUUIDIndexKey is the name of the cache index that maps a vCenter library UUID to the ContentLibrary
+// and ClusterContentLibrary resources referring to it.
+const LibraryUUIDIndexKey = "uuid"
+
+// LibraryUUIDIndexFunc indexes ContentLibrary and ClusterContentLibrary resources by their vCenter library UUIDs,
+// that is the UUID of their spec and, for a ContentLibrary the operator created, the UUID of their status. It
+// has the signature of an informer cache index function, so webhooks can look up the libraries referring to a
+// UUID without listing every library resource. The UUIDs are normalized with v1alpha1.NormalizeUUID, so the
+// keys looked up in the index must be normalized too; the package documentation shows how to register the
+// index and look up the libraries.
+func LibraryUUIDIndexFunc(obj interface{}) ([]string, error) {
+	return libraryUUIDs(obj), nil
+}
+
+// ValidateLibraryUUIDUnique validates that no other library resource in existing refers to the same vCenter
+// library as library, unless library has the AllowDuplicateUUIDAnnotationKey annotation. The existing
+// libraries are typically looked up with LibraryUUIDIndexFunc, and may include library itself.
+func ValidateLibraryUUIDUnique(library metav1.Object, existing []metav1.Object) field.ErrorList {
+	uuids := libraryUUIDs(library)
+	if len(uuids) == 0 || library.GetAnnotations()[v1alpha1.AllowDuplicateUUIDAnnotationKey] == "true" {
+		return nil
+	}
+
+	for _, other := range existing {
+		if other.GetNamespace() == library.GetNamespace() && other.GetName() == library.GetName() {
+			continue
+		}
+		for _, otherUUID := range libraryUUIDs(other) {
+			for _, uuid := range uuids {
+				if otherUUID == uuid {
+					return field.ErrorList{field.Invalid(specPath.Child("uuid"), uuid,
+						fmt.Sprintf("the library is already referred to by %s", describe(other)))}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateContentLibrary validates the creation of a ContentLibrary. A ContentLibrary either adopts an
+// existing library in vCenter or has the operator create one, but not both. Only a library created by the
+// operator can be deleted in vCenter with its ContentLibrary, and only with an explicit confirmation.
+func ValidateContentLibrary(library *v1alpha1.ContentLibrary) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case library.Spec.UUID == "" && library.Spec.Create == nil:
+		allErrs = append(allErrs, field.Required(specPath.Child("uuid"), v1alpha1.LibraryModeMessage))
+	case library.Spec.UUID != "" && library.Spec.Create != nil:
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("create"), v1alpha1.LibraryModeMessage))
+	}
+
+	if library.Spec.DeletionPolicy == v1alpha1.DeletionPolicyDelete {
+		policyPath := specPath.Child("deletionPolicy")
+		switch {
+		case library.Spec.Create == nil:
+			allErrs = append(allErrs, field.Forbidden(policyPath, v1alpha1.DeletionPolicyCreatedOnlyMessage))
+		case library.Annotations[v1alpha1.ConfirmDeleteAnnotationKey] != library.Name:
+			allErrs = append(allErrs, field.Forbidden(policyPath,
+				fmt.Sprintf("the %s annotation must be set to %q to confirm that the library is deleted in vCenter",
+					v1alpha1.ConfirmDeleteAnnotationKey, library.Name)))
+		}
+	}
+
+	if create := library.Spec.Create; create != nil {
+		createPath := specPath.Child("create")
+		subscribed := create.Type == v1alpha1.ContentLibraryTypeSubscribed
+		switch {
+		case subscribed && create.Subscription == nil && library.Spec.Subscription == nil:
+			allErrs = append(allErrs, field.Required(specPath.Child("subscription"), v1alpha1.SubscriptionRequiredMessage))
+		case !subscribed && create.Subscription != nil:
+			allErrs = append(allErrs, field.Forbidden(createPath.Child("subscription"), v1alpha1.SubscriptionForbiddenMessage))
+		case !subscribed && library.Spec.Subscription != nil:
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("subscription"), v1alpha1.SubscriptionForbiddenMessage))
+		case create.Subscription != nil && library.Spec.Subscription != nil:
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("subscription"), v1alpha1.SubscriptionConflictMessage))
+		}
+	}
+
+	if create := library.Spec.Create; create != nil && create.Subscription != nil {
+		allErrs = append(allErrs, validateSubscriptionURL(create.Subscription.SubscriptionURL,
+			specPath.Child("create", "subscription", "subscriptionURL"))...)
+	}
+	if subscription := library.Spec.Subscription; subscription != nil {
+		allErrs = append(allErrs, validateSubscription(subscription, specPath.Child("subscription"))...)
+	}
+
+	allErrs = append(allErrs, validateStorage(library)...)
+	allErrs = append(allErrs, validateObservedLibraryType(library)...)
+
+	if tmpl := library.Spec.ItemMetadataTemplate; tmpl != nil {
+		allErrs = append(allErrs, validateItemMetadataTemplate(tmpl, specPath.Child("itemMetadataTemplate"))...)
+	}
+
+	return allErrs
+}
+
+// validateSubscription validates the subscription URL and SSL thumbprint of a subscription, which are also
+// checked by the CRD schema, so that the webhook rejects them on API servers that do not evaluate CEL rules.
+func validateSubscription(subscription *v1alpha1.SubscriptionSpec, path *field.Path) field.ErrorList {
+	allErrs := validateSubscriptionURL(subscription.SubscriptionURL, path.Child("subscriptionURL"))
+	if thumbprint := subscription.SSLThumbprint; thumbprint != "" && !thumbprintRegexp.MatchString(thumbprint) {
+		allErrs = append(allErrs, field.Invalid(path.Child("sslThumbprint"), thumbprint, v1alpha1.ThumbprintFormatMessage))
+	}
+	return allErrs
+}
+
+func validateSubscriptionURL(url string, path *field.Path) field.ErrorList {
+	if !urlRegexp.MatchString(url) {
+		return field.ErrorList{field.Invalid(path, url, v1alpha1.URLFormatMessage)}
+	}
+	return nil
+}
+
+// validateStorage validates that the storage policy of the library is only set by one of spec.storagePolicyID
+// and spec.storageClassName, and only for a writable library created by the operator, since the datastore of
+// an adopted library is already chosen.
+func validateStorage(library *v1alpha1.ContentLibrary) field.ErrorList {
+	var storagePath *field.Path
+	switch {
+	case library.Spec.StoragePolicyID != "" && library.Spec.StorageClassName != "":
+		return field.ErrorList{field.Forbidden(specPath.Child("storageClassName"), v1alpha1.StoragePolicyAndClassMessage)}
+	case library.Spec.StoragePolicyID != "":
+		storagePath = specPath.Child("storagePolicyID")
+	case library.Spec.StorageClassName != "":
+		storagePath = specPath.Child("storageClassName")
+	default:
+		return nil
+	}
+
+	if library.Spec.Create == nil || !library.Spec.Writable {
+		return field.ErrorList{field.Forbidden(storagePath, v1alpha1.StorageWritableCreatedOnlyMessage)}
+	}
+	return nil
+}
+
+// validateObservedLibraryType validates the spec of a library against the type of the library reported in
+// its status. The type of an adopted library is only known once the operator observed it in vCenter, so
+// unlike the type of a created library it cannot be checked by the CEL rules of the spec, and a rule of the
+// whole object would also reject the status updates of the operator.
+func validateObservedLibraryType(library *v1alpha1.ContentLibrary) field.ErrorList {
+	libraryType := library.Status.Type
+	if libraryType == "" {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	if libraryType == v1alpha1.ContentLibraryTypeSubscribed && library.Spec.Writable {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("writable"), v1alpha1.SubscribedLibraryWritableMessage))
+	}
+	if libraryType != v1alpha1.ContentLibraryTypeLocal && library.Spec.Publish != nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("publish"), v1alpha1.PublishNonLocalLibraryMessage))
+	}
+	if libraryType != v1alpha1.ContentLibraryTypeSubscribed && library.Spec.Subscription != nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("subscription"), v1alpha1.SubscriptionForbiddenMessage))
+	}
+
+	return allErrs
+}
+
+// validateItemMetadataTemplate validates that the template renders, with sample variables, to valid labels
+// and annotations, and that it does not set the reserved labels and annotations. The rendered keys are
+// checked, since a key template may only produce a reserved key once rendered.
+func validateItemMetadataTemplate(tmpl *v1alpha1.ItemMetadataTemplate, path *field.Path) field.ErrorList {
+	labels, annotations, err := v1alpha1.RenderItemMetadataTemplate(tmpl, sampleItemMetadataVariables)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, "", err.Error())}
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateUnreservedKeys(labels, path.Child("labels"), "the label is reserved")...)
+	allErrs = append(allErrs, validateUnreservedKeys(annotations, path.Child("annotations"), "the annotation is reserved")...)
+	allErrs = append(allErrs, metav1validation.ValidateLabels(labels, path.Child("labels"))...)
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(annotations, path.Child("annotations"))...)
+
+	return allErrs
+}
+
+func validateUnreservedKeys(metadata map[string]string, path *field.Path, message string) field.ErrorList {
+	var allErrs field.ErrorList
+	for key := range metadata {
+		if strings.HasPrefix(key, v1alpha1.GroupName+"/") {
+			allErrs = append(allErrs, field.Forbidden(path.Key(key), message))
+		}
+	}
+	return allErrs
+}
+
+// sampleItemMetadataVariables are the variables item metadata templates are validated with.
+var sampleItemMetadataVariables = v1alpha1.ItemMetadataVariables{
+	ItemName:       "ubuntu-22.04",
+	Type:           v1alpha1.ContentLibraryItemTypeOvf,
+	UUID:           "3f9b9d2e-5c1a-4d8e-9f2b-1a2b3c4d5e6f",
+	ContentVersion: "1",
+	LibraryName:    "library",
+}
+
+// ValidateContentLibraryUpdate validates an update of a ContentLibrary.
+func ValidateContentLibraryUpdate(newLibrary, oldLibrary *v1alpha1.ContentLibrary) field.ErrorList {
+	allErrs := ValidateContentLibrary(newLibrary)
+
+	if (newLibrary.Spec.Create == nil) != (oldLibrary.Spec.Create == nil) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("create"), v1alpha1.LibraryModeImmutableMessage))
+	} else if !apiequality.Semantic.DeepEqual(newLibrary.Spec.Create, oldLibrary.Spec.Create) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("create"), v1alpha1.CreateImmutableMessage))
+	}
+
+	if newLibrary.Spec.UUID != oldLibrary.Spec.UUID {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("uuid"), newLibrary.Spec.UUID, v1alpha1.UUIDImmutableMessage))
+	}
+
+	return allErrs
+}
+
+// ValidateLibraryNamespacePlacement validates that the vCenter library adopted by a ContentLibrary is assigned
+// to the namespace of the ContentLibrary, so that tenants cannot adopt the library of another namespace by
+// guessing its UUID. The assigned libraries are the libraries of the supervisor namespace configuration of
+// the namespace. Libraries created by the operator are not checked.
+func ValidateLibraryNamespacePlacement(library *v1alpha1.ContentLibrary, assigned []v1alpha1.LibraryID) field.ErrorList {
+	if library.Spec.UUID == "" {
+		return nil
+	}
+
+	for _, id := range assigned {
+		if id.Equal(v1alpha1.LibraryID(strings.TrimSpace(string(library.Spec.UUID)))) {
+			return nil
+		}
+	}
+
+	return field.ErrorList{field.Forbidden(specPath.Child("uuid"),
+		fmt.Sprintf("the library is not assigned to namespace %s", library.Namespace))}
+}
+
+// ValidateDefaultContentLibrary validates that library is not designated as the default library of its
+// namespace while another library in existing already is. The existing libraries are the libraries of the
+// namespace of library, and may include library itself.
+func ValidateDefaultContentLibrary(library *v1alpha1.ContentLibrary, existing []v1alpha1.ContentLibrary) field.ErrorList {
+	if !v1alpha1.IsDefaultContentLibrary(library) {
+		return nil
+	}
+
+	for i := range existing {
+		other := &existing[i]
+		if other.Namespace != library.Namespace || other.Name == library.Name {
+			continue
+		}
+		if v1alpha1.IsDefaultContentLibrary(other) {
+			return field.ErrorList{field.Forbidden(
+				field.NewPath("metadata", "labels").Key(v1alpha1.DefaultLibraryLabelKey),
+				fmt.Sprintf("ContentLibrary %s is already the default library of the namespace", other.Name))}
+		}
+	}
+
+	return nil
+}
+
+// libraryUUIDs returns the normalized, distinct vCenter library UUIDs a library resource refers to.
+func libraryUUIDs(obj interface{}) []string {
+	var candidates []v1alpha1.LibraryID
+	switch library := obj.(type) {
+	case *v1alpha1.ContentLibrary:
+		candidates = []v1alpha1.LibraryID{library.Spec.UUID, library.Status.UUID}
+	case *v1alpha1.ClusterContentLibrary:
+		candidates = []v1alpha1.LibraryID{library.Spec.UUID}
+	}
+
+	var uuids []string
+	for _, candidate := range candidates {
+		uuid := v1alpha1.NormalizeUUID(string(candidate))
+		if uuid != "" && (len(uuids) == 0 || uuids[0] != uuid) {
+			uuids = append(uuids, uuid)
+		}
+	}
+	return uuids
+}
+
+func describe(obj metav1.Object) string {
+	if _, ok := obj.(*v1alpha1.ClusterContentLibrary); ok {
+		return fmt.Sprintf("ClusterContentLibrary %s", obj.GetName())
+	}
+	return fmt.Sprintf("ContentLibrary %s/%s", obj.GetNamespace(), obj.GetName())
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateLibraryUUIDUnique(t *testing.T) {
+	const uuid = "dc1a7e76-4a30-4d5e-9a8b-3c1f4e3b2a10"
+
+	adopted := &v1alpha1.ContentLibrary{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "adopted"},
+		Spec:       v1alpha1.ContentLibrarySpec{UUID: uuid},
+	}
+	created := &v1alpha1.ContentLibrary{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "created"},
+		Status:     v1alpha1.ContentLibraryStatus{UUID: "DC1A7E76-4A30-4D5E-9A8B-3C1F4E3B2A10"},
+	}
+	cluster := &v1alpha1.ClusterContentLibrary{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Spec:       v1alpha1.ClusterContentLibrarySpec{UUID: uuid},
+	}
+
+	tests := []struct {
+		name     string
+		library  metav1.Object
+		existing []metav1.Object
+		expected []fieldError
+	}{
+		{name: "no other library", library: adopted, existing: []metav1.Object{adopted}},
+		{name: "another library adopted the UUID", library: adopted, existing: []metav1.Object{cluster}, expected: []fieldError{invalid("spec.uuid")}},
+		{name: "another library created the UUID", library: adopted, existing: []metav1.Object{created}, expected: []fieldError{invalid("spec.uuid")}},
+		{name: "a created library is adopted again", library: created, existing: []metav1.Object{adopted}, expected: []fieldError{invalid("spec.uuid")}},
+		{name: "a library without a UUID", library: &v1alpha1.ContentLibrary{}, existing: []metav1.Object{created}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validation.ValidateLibraryUUIDUnique(tt.library, tt.existing)
+			expectFieldErrors(t, errs, tt.expected...)
+		})
+	}
+}
+
+func TestLibraryUUIDIndexFunc(t *testing.T) {
+	library := &v1alpha1.ContentLibrary{
+		Spec:   v1alpha1.ContentLibrarySpec{UUID: "DC1A7E76-4A30-4D5E-9A8B-3C1F4E3B2A10"},
+		Status: v1alpha1.ContentLibraryStatus{UUID: "dc1a7e76-4a30-4d5e-9a8b-3c1f4e3b2a10"},
+	}
+	keys, err := validation.LibraryUUIDIndexFunc(library)
+	if err != nil || len(keys) != 1 || keys[0] != "dc1a7e76-4a30-4d5e-9a8b-3c1f4e3b2a10" {
+		t.Errorf("expected the normalized UUID to be indexed once, got %q, %v", keys, err)
+	}
+
+	library.Spec.UUID = ""
+	if keys, _ := validation.LibraryUUIDIndexFunc(library); len(keys) != 1 {
+		t.Errorf("expected the status UUID to be indexed, got %q", keys)
+	}
+}
+
+func TestValidateContentLibrarySubscription(t *testing.T) {
+	const thumbprint = "AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89:AB:CD:EF:01"
+
+	tests := []struct {
+		name         string
+		subscription v1alpha1.SubscriptionSpec
+		expected     []fieldError
+	}{
+		{
+			name:         "valid",
+			subscription: v1alpha1.SubscriptionSpec{SubscriptionURL: "https://publisher.example.com/lib.json", SSLThumbprint: thumbprint},
+		},
+		{
+			name:         "not an http URL",
+			subscription: v1alpha1.SubscriptionSpec{SubscriptionURL: "ftp://publisher.example.com/lib.json"},
+			expected:     []fieldError{invalid("spec.subscription.subscriptionURL")},
+		},
+		{
+			name:         "not a SHA-1 thumbprint",
+			subscription: v1alpha1.SubscriptionSpec{SubscriptionURL: "https://publisher.example.com/lib.json", SSLThumbprint: "AB:CD"},
+			expected:     []fieldError{invalid("spec.subscription.sslThumbprint")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := tt.subscription
+			library := &v1alpha1.ContentLibrary{
+				Spec: v1alpha1.ContentLibrarySpec{
+					Create:       &v1alpha1.ContentLibraryCreateSpec{Name: "library", Type: v1alpha1.ContentLibraryTypeSubscribed},
+					Subscription: &subscription,
+				},
+			}
+			expectFieldErrors(t, validation.ValidateContentLibrary(library), tt.expected...)
+		})
+	}
+}
+
+func TestValidateItemMetadataTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template v1alpha1.ItemMetadataTemplate
+		expected []fieldError
+	}{
+		{
+			name:     "valid",
+			template: v1alpha1.ItemMetadataTemplate{Labels: map[string]string{"os": "{{.ItemName}}"}},
+		},
+		{
+			name:     "a reserved label",
+			template: v1alpha1.ItemMetadataTemplate{Labels: map[string]string{v1alpha1.GroupName + "/os": "linux"}},
+			expected: []fieldError{forbidden("spec.itemMetadataTemplate.labels[imageregistry.vmware.com/os]")},
+		},
+		{
+			name:     "a label that renders to a reserved key",
+			template: v1alpha1.ItemMetadataTemplate{Labels: map[string]string{`{{"imageregistry.vmware.com"}}/os`: "linux"}},
+			expected: []fieldError{forbidden("spec.itemMetadataTemplate.labels[imageregistry.vmware.com/os]")},
+		},
+		{
+			name:     "an annotation that renders to a reserved key",
+			template: v1alpha1.ItemMetadataTemplate{Annotations: map[string]string{`{{"imageregistry.vmware.com"}}/approvals`: "[]"}},
+			expected: []fieldError{forbidden("spec.itemMetadataTemplate.annotations[imageregistry.vmware.com/approvals]")},
+		},
+		{
+			name: "two labels that render to the same key",
+			template: v1alpha1.ItemMetadataTemplate{Labels: map[string]string{
+				"os":              "linux",
+				`{{printf "os"}}`: "windows",
+			}},
+			expected: []fieldError{invalid("spec.itemMetadataTemplate")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := tt.template
+			library := &v1alpha1.ContentLibrary{
+				Spec: v1alpha1.ContentLibrarySpec{UUID: "dc1a7e76-4a30-4d5e-9a8b-3c1f4e3b2a10", ItemMetadataTemplate: &template},
+			}
+			expectFieldErrors(t, validation.ValidateContentLibrary(library), tt.expected...)
+		})
+	}
+}
+
+func TestValidateContentLibraryStorage(t *testing.T) {
+	created := func(writable bool, storagePolicyID, storageClassName string) *v1alpha1.ContentLibrary {
+		return &v1alpha1.ContentLibrary{
+			Spec: v1alpha1.ContentLibrarySpec{
+				Create:           &v1alpha1.ContentLibraryCreateSpec{Name: "library", Type: v1alpha1.ContentLibraryTypeLocal},
+				Writable:         writable,
+				StoragePolicyID:  storagePolicyID,
+				StorageClassName: storageClassName,
+			},
+		}
+	}
+	adopted := &v1alpha1.ContentLibrary{
+		Spec: v1alpha1.ContentLibrarySpec{
+			UUID:             "dc1a7e76-4a30-4d5e-9a8b-3c1f4e3b2a10",
+			Writable:         true,
+			StorageClassName: "wcp-storage-policy",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		library  *v1alpha1.ContentLibrary
+		expected []fieldError
+	}{
+		{name: "no storage policy", library: created(false, "", "")},
+		{name: "a storage policy of a writable created library", library: created(true, "policy-1", "")},
+		{name: "a storage class of a writable created library", library: created(true, "", "wcp-storage-policy")},
+		{
+			name:     "a storage policy and a storage class",
+			library:  created(true, "policy-1", "wcp-storage-policy"),
+			expected: []fieldError{forbidden("spec.storageClassName")},
+		},
+		{
+			name:     "a storage policy of a read-only library",
+			library:  created(false, "policy-1", ""),
+			expected: []fieldError{forbidden("spec.storagePolicyID")},
+		},
+		{
+			name:     "a storage class of an adopted library",
+			library:  adopted,
+			expected: []fieldError{forbidden("spec.storageClassName")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectFieldErrors(t, validation.ValidateContentLibrary(tt.library), tt.expected...)
+		})
+	}
+}
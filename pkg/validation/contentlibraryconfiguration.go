@@ -0,0 +1,33 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"sort"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateContentLibraryConfiguration validates the creation or update of the ContentLibraryConfiguration.
+func ValidateContentLibraryConfiguration(config *v1alpha1.ContentLibraryConfiguration) field.ErrorList {
+	var allErrs field.ErrorList
+
+	featureGatesPath := specPath.Child("featureGates")
+	for feature := range config.Spec.FeatureGates {
+		if _, ok := v1alpha1.DefaultFeatureGates[v1alpha1.Feature(feature)]; !ok {
+			allErrs = append(allErrs, field.NotSupported(featureGatesPath.Key(feature), feature, knownFeatures()))
+		}
+	}
+
+	return allErrs
+}
+
+func knownFeatures() []string {
+	features := make([]string, 0, len(v1alpha1.DefaultFeatureGates))
+	for feature := range v1alpha1.DefaultFeatureGates {
+		features = append(features, string(feature))
+	}
+	sort.Strings(features)
+	return features
+}
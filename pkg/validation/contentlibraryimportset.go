@@ -0,0 +1,52 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateContentLibraryImportSet validates the creation of a ContentLibraryImportSet.
+func ValidateContentLibraryImportSet(set *v1alpha1.ContentLibraryImportSet) field.ErrorList {
+	manifest := set.Spec.Manifest
+	if (manifest.URL == "") == (manifest.ConfigMapKeyRef == nil) {
+		return field.ErrorList{field.Invalid(specPath.Child("manifest"), manifest, v1alpha1.ImportSetManifestSourceMessage)}
+	}
+	return nil
+}
+
+// ValidateImportSetEntries validates the entries of the manifest of a ContentLibraryImportSet. The manifest is
+// not part of the resource, so the entries are not validated by the CRD schema and are validated when the
+// manifest is read instead. The errors refer to the entries by their index in the manifest.
+func ValidateImportSetEntries(entries []v1alpha1.ImportSetEntry) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	for i, entry := range entries {
+		entryPath := field.NewPath("manifest").Index(i)
+		switch {
+		case entry.Name == "":
+			allErrs = append(allErrs, field.Required(entryPath.Child("name"), ""))
+		case names[entry.Name]:
+			allErrs = append(allErrs, field.Duplicate(entryPath.Child("name"), entry.Name))
+		}
+		names[entry.Name] = true
+
+		switch entry.Type {
+		case v1alpha1.ContentLibraryItemTypeOvf, v1alpha1.ContentLibraryItemTypeIso, v1alpha1.ContentLibraryItemTypeFile:
+		default:
+			allErrs = append(allErrs, field.NotSupported(entryPath.Child("type"), entry.Type, []string{
+				string(v1alpha1.ContentLibraryItemTypeOvf),
+				string(v1alpha1.ContentLibraryItemTypeIso),
+				string(v1alpha1.ContentLibraryItemTypeFile),
+			}))
+		}
+
+		if entry.URL == "" {
+			allErrs = append(allErrs, field.Required(entryPath.Child("url"), ""))
+		}
+	}
+
+	return allErrs
+}
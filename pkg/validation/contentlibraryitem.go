@@ -0,0 +1,79 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	readOnlyLibraryMessage = "can only be set for items of a writable ContentLibrary"
+)
+
+var specPath = field.NewPath("spec")
+
+// ValidateContentLibraryItem validates the creation of a ContentLibraryItem that belongs to the given library.
+// The library may be nil if it does not exist yet.
+func ValidateContentLibraryItem(item *v1alpha1.ContentLibraryItem, library *v1alpha1.ContentLibrary) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !isWritable(library) {
+		if item.Spec.Name != "" {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("name"), readOnlyLibraryMessage))
+		}
+		if item.Spec.Description != "" {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("description"), readOnlyLibraryMessage))
+		}
+		if len(item.Spec.CustomMetadata) > 0 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("customMetadata"), readOnlyLibraryMessage))
+		}
+	}
+
+	allErrs = append(allErrs, validateCustomMetadata(item.Spec.CustomMetadata)...)
+
+	return allErrs
+}
+
+// ValidateContentLibraryItemUpdate validates an update of a ContentLibraryItem that belongs to the given library.
+// The library may be nil if it no longer exists.
+func ValidateContentLibraryItemUpdate(newItem, oldItem *v1alpha1.ContentLibraryItem,
+	library *v1alpha1.ContentLibrary) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if newItem.Spec.UUID != oldItem.Spec.UUID {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("uuid"), newItem.Spec.UUID, v1alpha1.UUIDImmutableMessage))
+	}
+
+	if !isWritable(library) {
+		if newItem.Spec.Name != oldItem.Spec.Name {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("name"), readOnlyLibraryMessage))
+		}
+		if newItem.Spec.Description != oldItem.Spec.Description {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("description"), readOnlyLibraryMessage))
+		}
+		if !apiequality.Semantic.DeepEqual(newItem.Spec.CustomMetadata, oldItem.Spec.CustomMetadata) {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("customMetadata"), readOnlyLibraryMessage))
+		}
+	}
+
+	allErrs = append(allErrs, validateCustomMetadata(newItem.Spec.CustomMetadata)...)
+
+	return allErrs
+}
+
+func validateCustomMetadata(metadata map[string]string) field.ErrorList {
+	size := 0
+	for key, value := range metadata {
+		size += len(key) + len(value)
+	}
+	if size > v1alpha1.CustomMetadataMaxBytes {
+		return field.ErrorList{field.TooLong(specPath.Child("customMetadata"), size, v1alpha1.CustomMetadataMaxBytes)}
+	}
+	return nil
+}
+
+func isWritable(library *v1alpha1.ContentLibrary) bool {
+	return library != nil && library.Spec.Writable
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/validation"
+)
+
+const itemUUID = "3f9b9d2e-5c1a-4d8e-9f2b-1a2b3c4d5e6f"
+
+// metadataOfSize returns custom metadata whose keys and values have the given size in total.
+func metadataOfSize(size int) map[string]string {
+	return map[string]string{"build": strings.Repeat("x", size-len("build"))}
+}
+
+func TestValidateContentLibraryItem(t *testing.T) {
+	writable := &v1alpha1.ContentLibrary{Spec: v1alpha1.ContentLibrarySpec{Writable: true}}
+	readOnly := &v1alpha1.ContentLibrary{}
+
+	tests := []struct {
+		name     string
+		spec     v1alpha1.ContentLibraryItemSpec
+		library  *v1alpha1.ContentLibrary
+		expected []fieldError
+	}{
+		{
+			name:    "a writable library",
+			spec:    v1alpha1.ContentLibraryItemSpec{Name: "ubuntu", Description: "Ubuntu", CustomMetadata: map[string]string{"build": "1"}},
+			library: writable,
+		},
+		{
+			name:    "a read-only library without desired values",
+			spec:    v1alpha1.ContentLibraryItemSpec{UUID: itemUUID},
+			library: readOnly,
+		},
+		{
+			name:    "a read-only library",
+			spec:    v1alpha1.ContentLibraryItemSpec{Name: "ubuntu", Description: "Ubuntu", CustomMetadata: map[string]string{"build": "1"}},
+			library: readOnly,
+			expected: []fieldError{forbidden("spec.name"), forbidden("spec.description"),
+				forbidden("spec.customMetadata")},
+		},
+		{
+			name:     "a missing library is read-only",
+			spec:     v1alpha1.ContentLibraryItemSpec{Name: "ubuntu"},
+			expected: []fieldError{forbidden("spec.name")},
+		},
+		{
+			name:    "custom metadata of the maximum size",
+			spec:    v1alpha1.ContentLibraryItemSpec{CustomMetadata: metadataOfSize(v1alpha1.CustomMetadataMaxBytes)},
+			library: writable,
+		},
+		{
+			name:     "custom metadata exceeding the maximum size",
+			spec:     v1alpha1.ContentLibraryItemSpec{CustomMetadata: metadataOfSize(v1alpha1.CustomMetadataMaxBytes + 1)},
+			library:  writable,
+			expected: []fieldError{tooLong("spec.customMetadata")},
+		},
+		{
+			name:     "the keys count towards the maximum size",
+			spec:     v1alpha1.ContentLibraryItemSpec{CustomMetadata: map[string]string{strings.Repeat("k", v1alpha1.CustomMetadataMaxBytes): "v"}},
+			library:  writable,
+			expected: []fieldError{tooLong("spec.customMetadata")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &v1alpha1.ContentLibraryItem{Spec: tt.spec}
+			expectFieldErrors(t, validation.ValidateContentLibraryItem(item, tt.library), tt.expected...)
+		})
+	}
+}
+
+func TestValidateContentLibraryItemUpdate(t *testing.T) {
+	writable := &v1alpha1.ContentLibrary{Spec: v1alpha1.ContentLibrarySpec{Writable: true}}
+	readOnly := &v1alpha1.ContentLibrary{}
+
+	oldItem := &v1alpha1.ContentLibraryItem{Spec: v1alpha1.ContentLibraryItemSpec{
+		UUID:           itemUUID,
+		Name:           "ubuntu",
+		Description:    "Ubuntu",
+		CustomMetadata: map[string]string{"build": "1"},
+	}}
+
+	tests := []struct {
+		name     string
+		update   func(*v1alpha1.ContentLibraryItemSpec)
+		library  *v1alpha1.ContentLibrary
+		expected []fieldError
+	}{
+		{
+			name:    "unchanged values of a read-only library",
+			update:  func(*v1alpha1.ContentLibraryItemSpec) {},
+			library: readOnly,
+		},
+		{
+			name: "a writable library",
+			update: func(spec *v1alpha1.ContentLibraryItemSpec) {
+				spec.Name, spec.Description, spec.CustomMetadata = "ubuntu-22.04", "Ubuntu 22.04", nil
+			},
+			library: writable,
+		},
+		{
+			name: "a read-only library",
+			update: func(spec *v1alpha1.ContentLibraryItemSpec) {
+				spec.Name, spec.Description, spec.CustomMetadata = "ubuntu-22.04", "Ubuntu 22.04", map[string]string{"build": "2"}
+			},
+			library: readOnly,
+			expected: []fieldError{forbidden("spec.name"), forbidden("spec.description"),
+				forbidden("spec.customMetadata")},
+		},
+		{
+			name:     "clearing the custom metadata of a read-only library",
+			update:   func(spec *v1alpha1.ContentLibraryItemSpec) { spec.CustomMetadata = nil },
+			library:  readOnly,
+			expected: []fieldError{forbidden("spec.customMetadata")},
+		},
+		{
+			name:     "a library that no longer exists is read-only",
+			update:   func(spec *v1alpha1.ContentLibraryItemSpec) { spec.Description = "" },
+			expected: []fieldError{forbidden("spec.description")},
+		},
+		{
+			name: "custom metadata of the maximum size",
+			update: func(spec *v1alpha1.ContentLibraryItemSpec) {
+				spec.CustomMetadata = metadataOfSize(v1alpha1.CustomMetadataMaxBytes)
+			},
+			library: writable,
+		},
+		{
+			name: "custom metadata exceeding the maximum size",
+			update: func(spec *v1alpha1.ContentLibraryItemSpec) {
+				spec.CustomMetadata = metadataOfSize(v1alpha1.CustomMetadataMaxBytes + 1)
+			},
+			library:  writable,
+			expected: []fieldError{tooLong("spec.customMetadata")},
+		},
+		{
+			name:     "another UUID",
+			update:   func(spec *v1alpha1.ContentLibraryItemSpec) { spec.UUID = "dc1a7e76-4a30-4d5e-9a8b-3c1f4e3b2a10" },
+			library:  writable,
+			expected: []fieldError{invalid("spec.uuid")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newItem := oldItem.DeepCopy()
+			tt.update(&newItem.Spec)
+			expectFieldErrors(t, validation.ValidateContentLibraryItemUpdate(newItem, oldItem, tt.library), tt.expected...)
+		})
+	}
+}
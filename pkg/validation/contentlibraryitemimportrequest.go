@@ -0,0 +1,44 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateContentLibraryItemImportRequest validates the creation of a ContentLibraryItemImportRequest in the
+// given namespace. The clusterLibrary is the ClusterContentLibrary spec.target.clusterContentLibraryRef refers
+// to, or nil if the request targets a ContentLibrary or the ClusterContentLibrary does not exist. The namespace
+// may be nil if it could not be looked up, in which case imports into a ClusterContentLibrary are forbidden.
+func ValidateContentLibraryItemImportRequest(request *v1alpha1.ContentLibraryItemImportRequest,
+	clusterLibrary *v1alpha1.ClusterContentLibrary, namespace *corev1.Namespace) field.ErrorList {
+	targetPath := specPath.Child("target")
+	target := request.Spec.Target
+
+	if (target.ContentLibraryRef == "") == (target.ClusterContentLibraryRef == "") {
+		return field.ErrorList{field.Invalid(targetPath, target, v1alpha1.ImportTargetMessage)}
+	}
+
+	if target.ClusterContentLibraryRef == "" {
+		return nil
+	}
+
+	refPath := targetPath.Child("clusterContentLibraryRef")
+	switch {
+	case clusterLibrary == nil:
+		return field.ErrorList{field.NotFound(refPath, target.ClusterContentLibraryRef)}
+	case !clusterLibrary.Spec.Writable:
+		return field.ErrorList{field.Forbidden(refPath,
+			fmt.Sprintf("ClusterContentLibrary %s is not writable", clusterLibrary.Name))}
+	case !ClusterContentLibraryAllowsNamespace(clusterLibrary, namespace):
+		return field.ErrorList{field.Forbidden(refPath,
+			fmt.Sprintf("ClusterContentLibrary %s does not allow imports from namespace %s",
+				clusterLibrary.Name, request.Namespace))}
+	}
+
+	return nil
+}
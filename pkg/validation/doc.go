@@ -0,0 +1,39 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+// Package validation contains the validation logic of the image registry admission webhooks.
+// The functions do not depend on any webhook framework, so every component admitting these
+// resources can share them.
+//
+// ValidateLibraryUUIDUnique needs the library resources referring to the UUIDs of the admitted library. They
+// are looked up in the informer caches of ContentLibrary and ClusterContentLibrary, which both have to be
+// indexed with LibraryUUIDIndexFunc before they are started, e.g. with client-go:
+//
+//	indexers := cache.Indexers{validation.LibraryUUIDIndexKey: validation.LibraryUUIDIndexFunc}
+//	if err := libraryInformer.AddIndexers(indexers); err != nil {
+//		return err
+//	}
+//	if err := clusterLibraryInformer.AddIndexers(indexers); err != nil {
+//		return err
+//	}
+//
+// The keys of the admitted library are the values returned by LibraryUUIDIndexFunc for it, so that the
+// lookup uses the same normalized UUIDs as the index:
+//
+//	keys, _ := validation.LibraryUUIDIndexFunc(library)
+//	var existing []metav1.Object
+//	for _, key := range keys {
+//		for _, informer := range []cache.SharedIndexInformer{libraryInformer, clusterLibraryInformer} {
+//			objs, err := informer.GetIndexer().ByIndex(validation.LibraryUUIDIndexKey, key)
+//			if err != nil {
+//				return err
+//			}
+//			for _, obj := range objs {
+//				existing = append(existing, obj.(metav1.Object))
+//			}
+//		}
+//	}
+//	errs := validation.ValidateLibraryUUIDUnique(library, existing)
+//
+// With controller-runtime, the index is registered with FieldIndexer.IndexField wrapping LibraryUUIDIndexFunc,
+// and the libraries are listed with client.MatchingFields{validation.LibraryUUIDIndexKey: key}.
+package validation
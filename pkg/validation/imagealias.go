@@ -0,0 +1,31 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateImageAlias validates the creation or update of an ImageAlias. Depending on the kind of spec.target,
+// item or clusterItem is the library item spec.target refers to, or nil if it does not exist. The other one
+// is ignored. A ContentLibraryItem target must be in the namespace of the alias.
+func ValidateImageAlias(alias *v1alpha1.ImageAlias, item *v1alpha1.ContentLibraryItem,
+	clusterItem *v1alpha1.ClusterContentLibraryItem) field.ErrorList {
+	target := alias.Spec.Target
+
+	var found bool
+	switch target.Kind {
+	case "ContentLibraryItem":
+		found = item != nil && item.Name == target.Name && item.Namespace == alias.Namespace
+	case "ClusterContentLibraryItem":
+		found = clusterItem != nil && clusterItem.Name == target.Name
+	}
+
+	if !found {
+		return field.ErrorList{field.NotFound(specPath.Child("target"), fmt.Sprintf("%s %s", target.Kind, target.Name))}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateImageAlias(t *testing.T) {
+	alias := func(kind, name string) *v1alpha1.ImageAlias {
+		return &v1alpha1.ImageAlias{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "ubuntu"},
+			Spec:       v1alpha1.ImageAliasSpec{Target: v1alpha1.ImageAliasTarget{Kind: kind, Name: name}},
+		}
+	}
+	item := &v1alpha1.ContentLibraryItem{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "item"}}
+	otherNamespaceItem := &v1alpha1.ContentLibraryItem{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "item"}}
+	clusterItem := &v1alpha1.ClusterContentLibraryItem{ObjectMeta: metav1.ObjectMeta{Name: "item"}}
+
+	tests := []struct {
+		name        string
+		alias       *v1alpha1.ImageAlias
+		item        *v1alpha1.ContentLibraryItem
+		clusterItem *v1alpha1.ClusterContentLibraryItem
+		valid       bool
+	}{
+		{name: "ContentLibraryItem target", alias: alias("ContentLibraryItem", "item"), item: item, valid: true},
+		{name: "ClusterContentLibraryItem target", alias: alias("ClusterContentLibraryItem", "item"), clusterItem: clusterItem, valid: true},
+		{name: "missing target", alias: alias("ContentLibraryItem", "item")},
+		{name: "target of another kind", alias: alias("ContentLibraryItem", "item"), clusterItem: clusterItem},
+		{name: "target with another name", alias: alias("ContentLibraryItem", "other"), item: item},
+		{name: "target in another namespace", alias: alias("ContentLibraryItem", "item"), item: otherNamespaceItem},
+		{name: "cluster target with another name", alias: alias("ClusterContentLibraryItem", "other"), clusterItem: clusterItem},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validation.ValidateImageAlias(tt.alias, tt.item, tt.clusterItem)
+			if tt.valid {
+				expectFieldErrors(t, errs)
+			} else {
+				expectFieldErrors(t, errs, notFound("spec.target"))
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"path"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateImageFamily validates the creation or update of an ImageFamily.
+func ValidateImageFamily(family *v1alpha1.ImageFamily) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if family.Spec.NamePattern == "" && family.Spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(specPath, v1alpha1.ImageFamilyCriteriaMessage))
+	}
+
+	if pattern := family.Spec.NamePattern; pattern != "" {
+		if _, err := path.Match(pattern, ""); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("namePattern"), pattern, err.Error()))
+		}
+	}
+
+	if family.Spec.Selector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(family.Spec.Selector, specPath.Child("selector"))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateItemFileReference validates the fields of an ItemFileReference embedded at path, without looking up
+// the library item it refers to.
+func ValidateItemFileReference(ref *v1alpha1.ItemFileReference, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	itemRefPath := path.Child("itemRef")
+	switch ref.ItemRef.Kind {
+	case "ContentLibraryItem", "ClusterContentLibraryItem":
+	default:
+		allErrs = append(allErrs, field.NotSupported(itemRefPath.Child("kind"), ref.ItemRef.Kind,
+			[]string{"ContentLibraryItem", "ClusterContentLibraryItem"}))
+	}
+	if ref.ItemRef.Name == "" {
+		allErrs = append(allErrs, field.Required(itemRefPath.Child("name"), ""))
+	}
+
+	if strings.ContainsAny(ref.FileName, "/\\") {
+		allErrs = append(allErrs, field.Invalid(path.Child("fileName"), ref.FileName, "must not contain a path separator"))
+	}
+
+	return allErrs
+}
+
+// ValidateItemFileReferenceTarget validates that the ContentLibraryItem or ClusterContentLibraryItem item is
+// the library item an ItemFileReference embedded at path refers to, and that it has the referred file at the
+// referred content version. If itemType is set, the library item must also be of that type, e.g. "Iso" for
+// the references attached to a VM as a CD-ROM.
+func ValidateItemFileReferenceTarget(ref *v1alpha1.ItemFileReference, path *field.Path, item metav1.Object,
+	itemType v1alpha1.ContentLibraryItemType) field.ErrorList {
+	var status itemFileStatus
+	switch obj := item.(type) {
+	case *v1alpha1.ContentLibraryItem:
+		status = itemFileStatus{"ContentLibraryItem", obj.Status.Type, obj.Status.ContentVersion, obj.Status.Files}
+	case *v1alpha1.ClusterContentLibraryItem:
+		status = itemFileStatus{"ClusterContentLibraryItem", obj.Status.Type, obj.Status.ContentVersion, obj.Status.Files}
+	default:
+		return field.ErrorList{field.Invalid(path.Child("itemRef"), ref.ItemRef, "must refer to a library item")}
+	}
+
+	if ref.ItemRef.Kind != status.kind || ref.ItemRef.Name != item.GetName() {
+		return field.ErrorList{field.NotFound(path.Child("itemRef"), ref.ItemRef)}
+	}
+
+	var allErrs field.ErrorList
+
+	if itemType != "" && status.itemType != itemType {
+		allErrs = append(allErrs, field.Invalid(path.Child("itemRef"), ref.ItemRef,
+			fmt.Sprintf("must refer to a library item of the %s type, not %s", itemType, status.itemType)))
+	}
+
+	if ref.ContentVersion != "" && ref.ContentVersion != status.contentVersion {
+		allErrs = append(allErrs, field.Invalid(path.Child("contentVersion"), ref.ContentVersion,
+			fmt.Sprintf("the library item is at content version %s", status.contentVersion)))
+	}
+
+	fileNamePath := path.Child("fileName")
+	switch {
+	case ref.FileName == "" && len(status.files) != 1:
+		allErrs = append(allErrs, field.Required(fileNamePath,
+			fmt.Sprintf("the library item has %d files", len(status.files))))
+	case ref.FileName != "" && !hasFile(status.files, ref.FileName):
+		allErrs = append(allErrs, field.NotFound(fileNamePath, ref.FileName))
+	}
+
+	return allErrs
+}
+
+type itemFileStatus struct {
+	kind           string
+	itemType       v1alpha1.ContentLibraryItemType
+	contentVersion string
+	files          []v1alpha1.FileInfo
+}
+
+func hasFile(files []v1alpha1.FileInfo, name string) bool {
+	for _, file := range files {
+		if file.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	serviceAccountUsernamePrefix = "system:serviceaccount:"
+
+	protectedStatusFieldMessage = "can only be changed by the controllers of the status writer allow-list"
+)
+
+// ProtectedStatusFields are the JSON paths of the status fields of ContentLibraryItem and
+// ClusterContentLibraryItem resources that are written by trusted controllers other than the content library
+// operator, e.g. the deployment counters written by vm-operator with v1alpha1.RecordDeploy. Only the users of
+// a StatusWriterAllowList can change them, so that tenants cannot spoof consumption data.
+var ProtectedStatusFields = []string{
+	"status.deployCount",
+	"status.lastDeployTime",
+	"status.lastUsedTime",
+}
+
+// StatusWriterAllowList lists the users allowed to change the ProtectedStatusFields. It is configured by the
+// component running the webhook, and must include the service account of the content library operator if it
+// changes these fields.
+type StatusWriterAllowList struct {
+	// ServiceAccounts are the service accounts allowed to change the protected fields.
+	ServiceAccounts []types.NamespacedName
+
+	// Groups are the groups whose members are allowed to change the protected fields, e.g.
+	// "system:serviceaccounts:vmware-system-vmop" for every service account of a namespace.
+	Groups []string
+}
+
+// Allows returns true if user is allowed to change the ProtectedStatusFields.
+func (allowList StatusWriterAllowList) Allows(user authenticationv1.UserInfo) bool {
+	for _, serviceAccount := range allowList.ServiceAccounts {
+		if user.Username == serviceAccountUsernamePrefix+serviceAccount.Namespace+":"+serviceAccount.Name {
+			return true
+		}
+	}
+	for _, allowed := range allowList.Groups {
+		for _, group := range user.Groups {
+			if group == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateStatusWriter validates that an update of a ContentLibraryItem or ClusterContentLibraryItem, either
+// of the resource or of its status subresource, by user does not change any of the ProtectedStatusFields
+// unless allowList allows the user. Updates of resources of any other kind are not restricted.
+func ValidateStatusWriter(newObj, oldObj metav1.Object, user authenticationv1.UserInfo,
+	allowList StatusWriterAllowList) field.ErrorList {
+	newFields, ok := protectedStatusFields(newObj)
+	if !ok {
+		return nil
+	}
+	oldFields, ok := protectedStatusFields(oldObj)
+	if !ok || allowList.Allows(user) {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	for i, path := range ProtectedStatusFields {
+		if !apiequality.Semantic.DeepEqual(newFields[i], oldFields[i]) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("status", path[len("status."):]),
+				protectedStatusFieldMessage))
+		}
+	}
+	return allErrs
+}
+
+// protectedStatusFields returns the values of the ProtectedStatusFields of obj, in the same order. It returns
+// false if obj is neither a ContentLibraryItem nor a ClusterContentLibraryItem, or is a nil pointer to one.
+func protectedStatusFields(obj metav1.Object) ([]interface{}, bool) {
+	switch item := obj.(type) {
+	case *v1alpha1.ContentLibraryItem:
+		if item != nil {
+			return []interface{}{item.Status.DeployCount, item.Status.LastDeployTime, item.Status.LastUsedTime}, true
+		}
+	case *v1alpha1.ClusterContentLibraryItem:
+		if item != nil {
+			return []interface{}{item.Status.DeployCount, item.Status.LastDeployTime, item.Status.LastUsedTime}, true
+		}
+	}
+	return nil, false
+}
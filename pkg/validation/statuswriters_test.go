@@ -0,0 +1,61 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/validation"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestValidateStatusWriter(t *testing.T) {
+	allowList := validation.StatusWriterAllowList{
+		ServiceAccounts: []types.NamespacedName{{Namespace: "vmware-system-vmop", Name: "vmop"}},
+	}
+	vmop := authenticationv1.UserInfo{Username: "system:serviceaccount:vmware-system-vmop:vmop"}
+	tenant := authenticationv1.UserInfo{Username: "tenant"}
+
+	deployed := &v1alpha1.ContentLibraryItem{Status: v1alpha1.ContentLibraryItemStatus{DeployCount: 1}}
+
+	now := metav1.Now()
+	used := &v1alpha1.ClusterContentLibraryItem{Status: v1alpha1.ClusterContentLibraryItemStatus{LastUsedTime: &now}}
+
+	tests := []struct {
+		name     string
+		newObj   metav1.Object
+		oldObj   metav1.Object
+		user     authenticationv1.UserInfo
+		expected []fieldError
+	}{
+		{name: "an allowed user changes a protected field", newObj: deployed, oldObj: &v1alpha1.ContentLibraryItem{}, user: vmop},
+		{
+			name:     "another user changes a protected field",
+			newObj:   deployed,
+			oldObj:   &v1alpha1.ContentLibraryItem{},
+			user:     tenant,
+			expected: []fieldError{forbidden("status.deployCount")},
+		},
+		{
+			name:     "another user changes a protected field of a cluster item",
+			newObj:   used,
+			oldObj:   &v1alpha1.ClusterContentLibraryItem{},
+			user:     tenant,
+			expected: []fieldError{forbidden("status.lastUsedTime")},
+		},
+		{name: "another user leaves the protected fields alone", newObj: deployed, oldObj: deployed.DeepCopy(), user: tenant},
+		{name: "a typed nil new object", newObj: (*v1alpha1.ContentLibraryItem)(nil), oldObj: deployed, user: tenant},
+		{name: "a typed nil old object", newObj: deployed, oldObj: (*v1alpha1.ClusterContentLibraryItem)(nil), user: tenant},
+		{name: "another kind", newObj: &v1alpha1.ContentLibrary{}, oldObj: &v1alpha1.ContentLibrary{}, user: tenant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validation.ValidateStatusWriter(tt.newObj, tt.oldObj, tt.user, allowList)
+			expectFieldErrors(t, errs, tt.expected...)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// DefaultMaxInFlightTransfersPerNamespace is the maximum number of transfers a namespace may have in flight
+// when neither the ContentLibraryConfiguration nor the namespace sets one.
+const DefaultMaxInFlightTransfersPerNamespace = 10
+
+// MaxInFlightTransfers returns the maximum number of ContentLibraryItemImportRequests and
+// ContentLibraryItemFileUploads the namespace may have in flight. The maximum of the ContentLibraryConfiguration,
+// which may be nil, can be lowered for a namespace with its MaxInFlightTransfersAnnotationKey annotation, but
+// not raised, since anyone who can annotate the namespace could otherwise lift the quota. The namespace may be
+// nil if it could not be looked up, in which case the maximum of the configuration applies.
+func MaxInFlightTransfers(namespace *corev1.Namespace, config *v1alpha1.ContentLibraryConfiguration) int {
+	max := DefaultMaxInFlightTransfersPerNamespace
+	if config != nil && config.Spec.MaxInFlightTransfersPerNamespace > 0 {
+		max = int(config.Spec.MaxInFlightTransfersPerNamespace)
+	}
+	if namespace == nil {
+		return max
+	}
+	if value, ok := namespace.Annotations[v1alpha1.MaxInFlightTransfersAnnotationKey]; ok {
+		if override, err := strconv.Atoi(value); err == nil && override > 0 && override < max {
+			return override
+		}
+	}
+	return max
+}
+
+// IsTransferInFlight returns true if obj is a ContentLibraryItemImportRequest or a
+// ContentLibraryItemFileUpload that has neither completed nor failed.
+func IsTransferInFlight(obj metav1.Object) bool {
+	switch transfer := obj.(type) {
+	case *v1alpha1.ContentLibraryItemImportRequest:
+		return transfer.Status.Phase != v1alpha1.ImportPhaseSucceeded && transfer.Status.Phase != v1alpha1.ImportPhaseFailed
+	case *v1alpha1.ContentLibraryItemFileUpload:
+		if transfer.Status.CompletionTime != nil {
+			return false
+		}
+		for _, file := range transfer.Status.Files {
+			if file.Status == v1alpha1.FileTransferStatusError {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// ValidateTransferQuota validates that a new ContentLibraryItemImportRequest or ContentLibraryItemFileUpload
+// does not exceed the maximum number of transfers its namespace may have in flight. The existing transfers
+// are the ContentLibraryItemImportRequests and ContentLibraryItemFileUploads of the namespace, and the
+// namespace and the ContentLibraryConfiguration may be nil.
+func ValidateTransferQuota(namespace *corev1.Namespace, config *v1alpha1.ContentLibraryConfiguration,
+	existing []metav1.Object) field.ErrorList {
+	inFlight := 0
+	for _, transfer := range existing {
+		if IsTransferInFlight(transfer) {
+			inFlight++
+		}
+	}
+
+	if max := MaxInFlightTransfers(namespace, config); inFlight >= max {
+		return field.ErrorList{field.Forbidden(field.NewPath("metadata", "namespace"),
+			fmt.Sprintf("the namespace already has %d transfers in flight, the maximum is %d", inFlight, max))}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/validation"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaxInFlightTransfers(t *testing.T) {
+	annotated := func(value string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "ns",
+			Annotations: map[string]string{v1alpha1.MaxInFlightTransfersAnnotationKey: value},
+		}}
+	}
+	config := &v1alpha1.ContentLibraryConfiguration{
+		Spec: v1alpha1.ContentLibraryConfigurationSpec{MaxInFlightTransfersPerNamespace: 5},
+	}
+
+	tests := []struct {
+		name      string
+		namespace *corev1.Namespace
+		config    *v1alpha1.ContentLibraryConfiguration
+		expected  int
+	}{
+		{name: "no namespace and no configuration", expected: validation.DefaultMaxInFlightTransfersPerNamespace},
+		{name: "no namespace", config: config, expected: 5},
+		{name: "namespace without annotation", namespace: &corev1.Namespace{}, config: config, expected: 5},
+		{name: "annotation lowers the maximum", namespace: annotated("2"), config: config, expected: 2},
+		{name: "annotation cannot raise the maximum", namespace: annotated("50"), config: config, expected: 5},
+		{name: "annotation cannot raise the default", namespace: annotated("50"), expected: validation.DefaultMaxInFlightTransfersPerNamespace},
+		{name: "invalid annotation", namespace: annotated("-1"), config: config, expected: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if max := validation.MaxInFlightTransfers(tt.namespace, tt.config); max != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, max)
+			}
+		})
+	}
+}
+
+func TestValidateTransferQuotaWithoutNamespace(t *testing.T) {
+	inFlight := &v1alpha1.ContentLibraryItemImportRequest{}
+	existing := make([]metav1.Object, validation.DefaultMaxInFlightTransfersPerNamespace)
+	for i := range existing {
+		existing[i] = inFlight
+	}
+	expectFieldErrors(t, validation.ValidateTransferQuota(nil, nil, existing[1:]))
+	expectFieldErrors(t, validation.ValidateTransferQuota(nil, nil, existing), forbidden("metadata.namespace"))
+}
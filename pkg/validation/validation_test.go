@@ -0,0 +1,45 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// fieldError identifies an error of a field.ErrorList by its type and field path, ignoring its value and
+// detail, e.g. "FieldValueForbidden: spec.writable".
+type fieldError string
+
+func forbidden(path string) fieldError { return newFieldError(field.ErrorTypeForbidden, path) }
+func invalid(path string) fieldError   { return newFieldError(field.ErrorTypeInvalid, path) }
+func notFound(path string) fieldError  { return newFieldError(field.ErrorTypeNotFound, path) }
+func tooLong(path string) fieldError   { return newFieldError(field.ErrorTypeTooLong, path) }
+
+func newFieldError(typ field.ErrorType, path string) fieldError {
+	return fieldError(string(typ) + ": " + path)
+}
+
+// expectFieldErrors fails the test unless errs consists of exactly the expected errors, in any order.
+func expectFieldErrors(t *testing.T, errs field.ErrorList, expected ...fieldError) {
+	t.Helper()
+	actual := make([]fieldError, 0, len(errs))
+	for _, err := range errs {
+		actual = append(actual, newFieldError(err.Type, err.Field))
+	}
+	sortFieldErrors(actual)
+
+	want := append([]fieldError{}, expected...)
+	sortFieldErrors(want)
+
+	if !reflect.DeepEqual(actual, want) {
+		t.Errorf("expected errors %q, got %q (%v)", want, actual, errs)
+	}
+}
+
+func sortFieldErrors(errs []fieldError) {
+	sort.Slice(errs, func(i, j int) bool { return errs[i] < errs[j] })
+}
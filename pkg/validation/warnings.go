@@ -0,0 +1,121 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1/install"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var scheme = func() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	return scheme
+}()
+
+// DeprecationWarnings returns the admission warnings for the deprecated kind and fields of
+// v1alpha1.Deprecations that newObj sets, so that webhooks can return them in the Warning headers of their
+// responses instead of rejecting the request. A deprecated field is reported when it is set to a value that
+// is not empty and differs from its value in oldObj, which is nil on creation, so that clients are not
+// warned about values they did not write.
+func DeprecationWarnings(newObj, oldObj runtime.Object) ([]string, error) {
+	gvks, _, err := scheme.ObjectKinds(newObj)
+	if err != nil {
+		return nil, err
+	}
+
+	var deprecations []v1alpha1.Deprecation
+	for _, deprecation := range v1alpha1.Deprecations() {
+		for _, gvk := range gvks {
+			if deprecation.GroupVersionKind == gvk {
+				deprecations = append(deprecations, deprecation)
+			}
+		}
+	}
+	if len(deprecations) == 0 {
+		return nil, nil
+	}
+
+	newValue, err := toJSONMap(newObj)
+	if err != nil {
+		return nil, err
+	}
+	var oldValue map[string]interface{}
+	if oldObj != nil && !reflect.ValueOf(oldObj).IsNil() {
+		if oldValue, err = toJSONMap(oldObj); err != nil {
+			return nil, err
+		}
+	}
+
+	var warnings []string
+	for _, deprecation := range deprecations {
+		if deprecation.FieldPath == "" {
+			if oldValue == nil {
+				warnings = append(warnings, deprecationWarning(deprecation.GroupVersionKind.Kind, deprecation))
+			}
+			continue
+		}
+
+		value := lookupJSONPath(newValue, deprecation.FieldPath)
+		if isEmptyJSONValue(value) || reflect.DeepEqual(value, lookupJSONPath(oldValue, deprecation.FieldPath)) {
+			continue
+		}
+		warnings = append(warnings, deprecationWarning(deprecation.FieldPath, deprecation))
+	}
+
+	return warnings, nil
+}
+
+func deprecationWarning(subject string, deprecation v1alpha1.Deprecation) string {
+	warning := fmt.Sprintf("%s is deprecated: %s", subject, deprecation.Message)
+	if deprecation.Replacement != "" {
+		warning += fmt.Sprintf(", use %s instead", deprecation.Replacement)
+	}
+	return warning
+}
+
+func toJSONMap(obj runtime.Object) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value map[string]interface{}
+	return value, json.Unmarshal(data, &value)
+}
+
+// lookupJSONPath returns the value of the dot separated path of fields in value, or nil if it is not set.
+func lookupJSONPath(value map[string]interface{}, path string) interface{} {
+	var current interface{} = value
+	for _, name := range strings.Split(path, ".") {
+		fields, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = fields[name]
+	}
+	return current
+}
+
+func isEmptyJSONValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case float64:
+		return v == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2022 VMware, Inc. All Rights Reserved.
+
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/acharyasreej/vm-imgreg-operator-api/api/v1alpha1"
+	"github.com/acharyasreej/vm-imgreg-operator-api/pkg/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func subscribedLibrary(deprecatedSubscription bool) *v1alpha1.ContentLibrary {
+	library := &v1alpha1.ContentLibrary{
+		Spec: v1alpha1.ContentLibrarySpec{
+			Create: &v1alpha1.ContentLibraryCreateSpec{Name: "library", Type: v1alpha1.ContentLibraryTypeSubscribed},
+		},
+	}
+	subscription := v1alpha1.SubscriptionInfo{SubscriptionURL: "https://publisher.example.com/lib.json"}
+	if deprecatedSubscription {
+		library.Spec.Create.Subscription = &subscription
+	}
+	return library
+}
+
+func TestDeprecationWarnings(t *testing.T) {
+	const subscriptionWarning = "spec.create.subscription is deprecated: spec.create.subscription cannot be " +
+		"changed after the library is created, use spec.subscription instead"
+	const cachedWarning = "status.cached is deprecated: status.cached does not report why the library item " +
+		"files are not on disk, use status.cacheStatus instead"
+
+	cachedItem := &v1alpha1.ContentLibraryItem{Status: v1alpha1.ContentLibraryItemStatus{Cached: true}}
+
+	tests := []struct {
+		name     string
+		newObj   runtime.Object
+		oldObj   runtime.Object
+		expected []string
+	}{
+		{
+			name:   "no deprecated field is set",
+			newObj: subscribedLibrary(false),
+		},
+		{
+			name:     "a deprecated field is set on creation",
+			newObj:   subscribedLibrary(true),
+			expected: []string{subscriptionWarning},
+		},
+		{
+			name:     "a deprecated field is set on update",
+			newObj:   subscribedLibrary(true),
+			oldObj:   subscribedLibrary(false),
+			expected: []string{subscriptionWarning},
+		},
+		{
+			name:   "a deprecated field is left unchanged",
+			newObj: subscribedLibrary(true),
+			oldObj: subscribedLibrary(true),
+		},
+		{
+			name:   "a deprecated field is set to its zero value",
+			newObj: &v1alpha1.ContentLibraryItem{},
+		},
+		{
+			name:     "a deprecated status field is set",
+			newObj:   cachedItem,
+			oldObj:   &v1alpha1.ContentLibraryItem{},
+			expected: []string{cachedWarning},
+		},
+		{
+			name:   "a typed nil old object is ignored",
+			newObj: subscribedLibrary(false),
+			oldObj: (*v1alpha1.ContentLibrary)(nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := validation.DeprecationWarnings(tt.newObj, tt.oldObj)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(warnings, tt.expected) {
+				t.Errorf("expected warnings %q, got %q", tt.expected, warnings)
+			}
+		})
+	}
+}